@@ -17,4 +17,6 @@ var serveCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(serveCmd)
 	serveCmd.AddCommand(serve.GrpcServerCmd)
+	serveCmd.AddCommand(serve.RelayCmd)
+	serveCmd.AddCommand(serve.WebhooksCmd)
 }
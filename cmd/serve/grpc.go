@@ -1,12 +1,19 @@
 package serve
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/shewitt93/explore_service/internal/database"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/pubsub"
+	"github.com/shewitt93/explore_service/internal/quota"
 	"github.com/shewitt93/explore_service/internal/repository"
 	"github.com/shewitt93/explore_service/internal/server"
+	"github.com/shewitt93/explore_service/internal/validation"
 	"github.com/shewitt93/explore_service/pkg/grpclibs"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -14,7 +21,10 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 )
 
 var GrpcServerCmd = &cobra.Command{
@@ -38,12 +48,51 @@ func startGrpcServer(cmd *cobra.Command, args []string) {
 	defer db.Close()
 
 	decisionRepository := repository.NewDecisionRepositoryImpl(db)
+	blockRepository := repository.NewBlockRepositoryImpl(db)
+	idempotencyRepository := repository.NewIdempotencyRepositoryImpl(db)
+
+	config, err := initServerConfig()
+	if err != nil {
+		log.Fatalf("Invalid server configuration: %v", err)
+	}
+
+	// A WatchLikedYou stream that falls this many events behind is dropped rather than holding up decisions
+	watchBufferSize, err := getEnvUintWithDefault("WATCH_BUFFER_SIZE", 64)
+	if err != nil {
+		log.Fatalf("Invalid server configuration: %v", err)
+	}
+	likes := pubsub.NewBroker[entity.LikeEvent](int(watchBufferSize))
+
+	// Expired idempotency keys are ignored by requests, but stay in the table until they're deleted here
+	cleanupInterval, err := time.ParseDuration(getEnvWithDefault("IDEMPOTENCY_CLEANUP_INTERVAL", "10m"))
+	if err != nil || cleanupInterval <= 0 {
+		log.Fatalf("Invalid server configuration: IDEMPOTENCY_CLEANUP_INTERVAL must be a positive duration")
+	}
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	defer stopCleanup()
+	go deleteExpiredIdempotencyKeys(cleanupCtx, idempotencyRepository, cleanupInterval)
+
+	entitlementRepository := repository.NewEntitlementRepositoryImpl(db)
+	quotas, err := initLikeQuotas(decisionRepository, entitlementRepository)
+	if err != nil {
+		log.Fatalf("Invalid server configuration: %v", err)
+	}
+
+	users, err := initUserLookup(db)
+	if err != nil {
+		log.Fatalf("Invalid server configuration: %v", err)
+	}
 
 	s := grpc.NewServer()
 
-	grpcServer := server.NewExploreGRPCServer(decisionRepository)
+	grpcServer := server.NewExploreGRPCServer(decisionRepository, blockRepository, idempotencyRepository, likes, quotas, users, config)
 	grpclibs.RegisterExploreServiceServer(s, grpcServer)
 
+	deliverer, err := initWebhookDeliverer()
+	if err != nil {
+		log.Fatalf("Invalid server configuration: %v", err)
+	}
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", os.Getenv("GRPC_PORT")))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
@@ -51,31 +100,92 @@ func startGrpcServer(cmd *cobra.Command, args []string) {
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGTERM)
 
-	go func() {
-		// If the server stops, that send the kill signal
-		// The containers are set to auto-restart, so this will restart the container
-		defer func() {
-			log.Println("Sending kill signal")
+	go serveUntilStopped(s, listener, signalChan)
 
-			signalChan <- syscall.SIGTERM
-		}()
+	// The admin service has no authentication, so it gets its own listener that must only be reachable internally
+	admin := grpc.NewServer()
+	adminServer := server.NewExploreAdminGRPCServer(decisionRepository, repository.NewWebhookRepositoryImpl(db), entitlementRepository, deliverer, config)
+	grpclibs.RegisterExploreAdminServiceServer(admin, adminServer)
 
-		log.Printf("Starting server on port %s\n", os.Getenv("GRPC_PORT"))
-
-		if err := s.Serve(listener); err != nil {
-			log.Printf("Failed to serve: %v\n", err)
-		}
-	}()
+	adminListener, err := listenAdmin()
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	if adminListener != nil {
+		go serveUntilStopped(admin, adminListener, signalChan)
+	} else {
+		log.Println("ADMIN_GRPC_PORT is not set, not serving the admin service")
+	}
 
 	// Wait for the signal
 	<-signalChan
 
-	// This essentially stops the server, but only after all current requests have been completed
+	// This essentially stops the servers, but only after all current requests have been completed
 	s.GracefulStop()
+	admin.GracefulStop()
 
 	log.Println("Server stopped")
 }
 
+// serveUntilStopped serves the server on the listener, sending the kill signal once it stops
+func serveUntilStopped(s *grpc.Server, listener net.Listener, signalChan chan<- os.Signal) {
+	// If the server stops, that send the kill signal
+	// The containers are set to auto-restart, so this will restart the container
+	defer func() {
+		log.Println("Sending kill signal")
+
+		signalChan <- syscall.SIGTERM
+	}()
+
+	log.Printf("Starting server on %s\n", listener.Addr())
+
+	if err := s.Serve(listener); err != nil {
+		log.Printf("Failed to serve: %v\n", err)
+	}
+}
+
+// listenAdmin listens for the admin service on ADMIN_GRPC_HOST (127.0.0.1) and ADMIN_GRPC_PORT.
+// The host should only ever be set to an internal interface. There's no listener if ADMIN_GRPC_PORT isn't set.
+func listenAdmin() (net.Listener, error) {
+	port := os.Getenv("ADMIN_GRPC_PORT")
+	if port == "" {
+		return nil, nil
+	}
+	if port == os.Getenv("GRPC_PORT") {
+		return nil, fmt.Errorf("ADMIN_GRPC_PORT must differ from GRPC_PORT")
+	}
+
+	return net.Listen("tcp", net.JoinHostPort(getEnvWithDefault("ADMIN_GRPC_HOST", "127.0.0.1"), port))
+}
+
+// deleteExpiredIdempotencyKeys deletes the expired idempotency keys every interval until the context ends.
+// They're deleted in batches so no delete holds its locks for long.
+func deleteExpiredIdempotencyKeys(ctx context.Context, keys repository.IdempotencyRepository, interval time.Duration) {
+	const batchSize = 1000
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			deleted, err := keys.DeleteExpired(ctx, batchSize)
+			if err != nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+				break
+			}
+			if deleted < batchSize {
+				break
+			}
+		}
+	}
+}
+
 func initDB() (*sql.DB, error) {
 	// Get database connection details from environment variables
 	dbConfig := database.ConfigDatabase{
@@ -94,6 +204,160 @@ func initDB() (*sql.DB, error) {
 	return database.NewMysqlConnection(dsn)
 }
 
+func initServerConfig() (server.Config, error) {
+	defaultPageSize, err := getEnvUintWithDefault("DEFAULT_PAGE_SIZE", 50)
+	if err != nil {
+		return server.Config{}, err
+	}
+
+	maxPageSize, err := getEnvUintWithDefault("MAX_PAGE_SIZE", 500)
+	if err != nil {
+		return server.Config{}, err
+	}
+
+	if defaultPageSize == 0 || defaultPageSize > maxPageSize {
+		return server.Config{}, fmt.Errorf("DEFAULT_PAGE_SIZE must be between 1 and MAX_PAGE_SIZE (%d)", maxPageSize)
+	}
+
+	cursors, err := initCursorCodec()
+	if err != nil {
+		return server.Config{}, err
+	}
+
+	maxBatchSize, err := getEnvUintWithDefault("MAX_BATCH_SIZE", 500)
+	if err != nil {
+		return server.Config{}, err
+	}
+	if maxBatchSize == 0 {
+		return server.Config{}, fmt.Errorf("MAX_BATCH_SIZE must be at least 1")
+	}
+
+	undoWindow, err := time.ParseDuration(getEnvWithDefault("UNDO_WINDOW", "5m"))
+	if err != nil {
+		return server.Config{}, fmt.Errorf("invalid value for UNDO_WINDOW: %w", err)
+	}
+
+	idempotencyTTL, err := time.ParseDuration(getEnvWithDefault("IDEMPOTENCY_TTL", "24h"))
+	if err != nil {
+		return server.Config{}, fmt.Errorf("invalid value for IDEMPOTENCY_TTL: %w", err)
+	}
+
+	idFormat, err := validation.ParseIDFormat(getEnvWithDefault("USER_ID_FORMAT", string(validation.IDFormatAny)))
+	if err != nil {
+		return server.Config{}, fmt.Errorf("invalid value for USER_ID_FORMAT: %w", err)
+	}
+
+	maxIDLength, err := getEnvUintWithDefault("MAX_USER_ID_LENGTH", validation.MaxIDLength)
+	if err != nil {
+		return server.Config{}, err
+	}
+	if maxIDLength == 0 || maxIDLength > validation.MaxIDLength {
+		return server.Config{}, fmt.Errorf("MAX_USER_ID_LENGTH must be between 1 and %d", validation.MaxIDLength)
+	}
+
+	return server.Config{
+		DefaultPageSize: defaultPageSize,
+		MaxPageSize:     maxPageSize,
+		Cursors:         cursors,
+		MaxBatchSize:    maxBatchSize,
+		UndoWindow:      undoWindow,
+		IdempotencyTTL:  idempotencyTTL,
+		Validation:      validation.Rules{IDFormat: idFormat, MaxIDLength: int(maxIDLength)},
+	}, nil
+}
+
+// initLikeQuotas reads the likes allowed per LIKE_QUOTA_WINDOW for each tier from LIKE_QUOTAS, a comma separated list
+// of tier:limit pairs. Tiers that aren't listed are unlimited
+func initLikeQuotas(decisions repository.DecisionRepository, entitlements repository.EntitlementRepository) (*quota.Limiter, error) {
+	window, err := time.ParseDuration(getEnvWithDefault("LIKE_QUOTA_WINDOW", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for LIKE_QUOTA_WINDOW: %w", err)
+	}
+
+	limits := make(map[quota.Tier]uint32)
+	for _, pair := range strings.Split(getEnvWithDefault("LIKE_QUOTAS", "free:100"), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		tier, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid value for LIKE_QUOTAS: expected tier:limit")
+		}
+		limit, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid like quota for tier %q: %w", tier, err)
+		}
+		limits[quota.Tier(tier)] = uint32(limit)
+	}
+
+	// Users are put on a tier with SetUserTier, everyone else is on LIKE_QUOTA_DEFAULT_TIER
+	tiers := quota.NewStoredTier(entitlements, quota.Tier(getEnvWithDefault("LIKE_QUOTA_DEFAULT_TIER", string(quota.TierFree))))
+	return quota.NewLimiter(decisions, tiers, limits, window), nil
+}
+
+// initUserLookup returns how decisions check their users exist. With USER_EXISTENCE_CHECK=true users are looked up
+// in the user table and remembered for USER_CACHE_TTL, up to USER_CACHE_SIZE at once; otherwise they aren't checked
+func initUserLookup(db *sql.DB) (repository.UserLookup, error) {
+	enabled, err := strconv.ParseBool(getEnvWithDefault("USER_EXISTENCE_CHECK", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for USER_EXISTENCE_CHECK: %w", err)
+	}
+	if !enabled {
+		return repository.UncheckedUserLookup{}, nil
+	}
+
+	ttl, err := time.ParseDuration(getEnvWithDefault("USER_CACHE_TTL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for USER_CACHE_TTL: %w", err)
+	}
+
+	size, err := getEnvUintWithDefault("USER_CACHE_SIZE", 100000)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.NewCachingUserLookup(repository.NewUserLookupImpl(db), ttl, int(size)), nil
+}
+
+// initCursorCodec reads the pagination token signing keys from CURSOR_KEYS, a comma separated list of
+// id:base64-secret pairs with the key to sign new tokens with first
+func initCursorCodec() (*entity.CursorCodec, error) {
+	ttl, err := time.ParseDuration(getEnvWithDefault("CURSOR_TTL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for CURSOR_TTL: %w", err)
+	}
+
+	var keys []entity.CursorKey
+	for _, pair := range strings.Split(os.Getenv("CURSOR_KEYS"), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		id, encodedSecret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid value for CURSOR_KEYS: expected id:secret")
+		}
+		secret, err := base64.StdEncoding.DecodeString(encodedSecret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret for cursor key %q: %w", id, err)
+		}
+		keys = append(keys, entity.CursorKey{ID: id, Secret: secret})
+	}
+
+	if len(keys) == 0 {
+		// Tokens signed with a random key won't survive a restart or be accepted by other instances
+		log.Println("CURSOR_KEYS is not set, signing pagination tokens with a random key")
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate cursor key: %w", err)
+		}
+		keys = append(keys, entity.CursorKey{ID: "random", Secret: secret})
+	}
+
+	return entity.NewCursorCodec(keys, ttl)
+}
+
 func getEnvWithDefault(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -101,3 +365,16 @@ func getEnvWithDefault(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getEnvUintWithDefault(key string, defaultValue uint32) (uint32, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return uint32(parsed), nil
+}
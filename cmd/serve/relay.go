@@ -0,0 +1,98 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/outbox"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+var RelayCmd = &cobra.Command{
+	Use:   "relay",
+	Short: "Publish decision and match events from the outbox",
+	Run:   startRelay,
+}
+
+func startRelay(cmd *cobra.Command, args []string) {
+	log.Println("starting outbox relay")
+	db, err := initDB()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer db.Close()
+
+	publisher, closePublisher, err := initPublisher(repository.NewWebhookRepositoryImpl(db))
+	if err != nil {
+		log.Fatalf("Failed to initialize publisher: %v", err)
+	}
+	defer closePublisher()
+
+	batchSize, err := getEnvUintWithDefault("OUTBOX_BATCH_SIZE", 100)
+	if err != nil {
+		log.Fatalf("Invalid relay configuration: %v", err)
+	}
+	interval, err := time.ParseDuration(getEnvWithDefault("OUTBOX_POLL_INTERVAL", "1s"))
+	if err != nil {
+		log.Fatalf("Invalid relay configuration: invalid value for OUTBOX_POLL_INTERVAL: %v", err)
+	}
+
+	relay := outbox.NewRelay(repository.NewOutboxRepositoryImpl(db), publisher, int(batchSize), interval)
+
+	// Stop after the batch in flight when signalled, anything unmarked is published again next time
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := relay.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Printf("Relay stopped: %v", err)
+	}
+
+	log.Println("Relay stopped")
+}
+
+// initPublisher returns the publishers chosen by OUTBOX_PUBLISHER, a comma separated list of stdout, file
+// (named by OUTBOX_FILE) and webhook, along with a function to release them
+func initPublisher(webhooks repository.WebhookRepository) (outbox.Publisher, func(), error) {
+	var publishers outbox.MultiPublisher
+	var closers []func()
+	closeAll := func() {
+		for _, release := range closers {
+			release()
+		}
+	}
+
+	for _, name := range strings.Split(getEnvWithDefault("OUTBOX_PUBLISHER", "stdout"), ",") {
+		switch name = strings.TrimSpace(name); name {
+		case "stdout":
+			publishers = append(publishers, outbox.NewWriterPublisher(os.Stdout))
+		case "file":
+			file, err := os.OpenFile(getEnvWithDefault("OUTBOX_FILE", "outbox.jsonl"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				closeAll()
+				return nil, nil, fmt.Errorf("failed to open outbox file: %w", err)
+			}
+			publishers = append(publishers, outbox.NewWriterPublisher(file))
+			closers = append(closers, func() { file.Close() })
+		case "webhook":
+			// Only queues the deliveries, they're sent by ./main serve webhooks
+			publishers = append(publishers, webhook.NewPublisher(webhooks))
+		default:
+			closeAll()
+			return nil, nil, fmt.Errorf("unknown OUTBOX_PUBLISHER %q, expected stdout, file or webhook", name)
+		}
+	}
+
+	if len(publishers) == 1 {
+		return publishers[0], closeAll, nil
+	}
+	return publishers, closeAll, nil
+}
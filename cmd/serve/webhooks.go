@@ -0,0 +1,84 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+var WebhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Deliver queued webhook events, retrying and dead-lettering failures",
+	Run:   startWebhookWorker,
+}
+
+func startWebhookWorker(cmd *cobra.Command, args []string) {
+	log.Println("starting webhook worker")
+	db, err := initDB()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer db.Close()
+
+	deliverer, err := initWebhookDeliverer()
+	if err != nil {
+		log.Fatalf("Invalid webhook configuration: %v", err)
+	}
+
+	batchSize, err := getEnvUintWithDefault("WEBHOOK_BATCH_SIZE", 100)
+	if err != nil {
+		log.Fatalf("Invalid webhook configuration: %v", err)
+	}
+	interval, err := time.ParseDuration(getEnvWithDefault("WEBHOOK_POLL_INTERVAL", "1s"))
+	if err != nil {
+		log.Fatalf("Invalid webhook configuration: invalid value for WEBHOOK_POLL_INTERVAL: %v", err)
+	}
+
+	worker := webhook.NewWorker(repository.NewWebhookRepositoryImpl(db), deliverer, int(batchSize), interval)
+
+	// Stop when signalled, deliveries cut off aren't counted as attempts and are still due next time
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := worker.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Printf("Webhook worker stopped: %v", err)
+	}
+
+	log.Println("Webhook worker stopped")
+}
+
+// initWebhookDeliverer returns the deliverer webhooks are sent and retried with, configured by the WEBHOOK_ variables
+func initWebhookDeliverer() (*webhook.Deliverer, error) {
+	maxAttempts, err := getEnvUintWithDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	if err != nil {
+		return nil, err
+	}
+	if maxAttempts == 0 {
+		return nil, fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be at least 1")
+	}
+
+	retryDelay, err := time.ParseDuration(getEnvWithDefault("WEBHOOK_RETRY_DELAY", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for WEBHOOK_RETRY_DELAY: %w", err)
+	}
+
+	maxRetryDelay, err := time.ParseDuration(getEnvWithDefault("WEBHOOK_MAX_RETRY_DELAY", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for WEBHOOK_MAX_RETRY_DELAY: %w", err)
+	}
+
+	timeout, err := time.ParseDuration(getEnvWithDefault("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for WEBHOOK_TIMEOUT: %w", err)
+	}
+
+	return webhook.NewDeliverer(webhook.NewClient(timeout), int(maxAttempts), retryDelay, maxRetryDelay), nil
+}
@@ -9,11 +9,145 @@ CREATE TABLE `user` (
 CREATE TABLE user_decisions (
     actor_id VARCHAR(255) NOT NULL,
     recipient_id VARCHAR(255) NOT NULL,
-    liked BOOLEAN NOT NULL,
+    decision_type ENUM('pass', 'like', 'super_like') NOT NULL,
+    -- Derived from decision_type so likes of either kind can be found and indexed
+    liked BOOLEAN AS (decision_type <> 'pass') STORED,
+    super_liked BOOLEAN AS (decision_type = 'super_like') STORED,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    -- The decision this one replaced, kept so it can be undone.
+    -- previous_updated_at is only NULL for a decision that was never replaced, and stays set when an undo restores one.
+    previous_decision_type ENUM('pass', 'like', 'super_like') NULL,
+    previous_updated_at TIMESTAMP NULL,
+    -- Set when this like forms a match, the later of the two users' is when their current match formed
+    matched_at TIMESTAMP NULL,
     PRIMARY KEY (actor_id, recipient_id),
     INDEX idx_recipient_liked (recipient_id, liked),
-    INDEX idx_recipient_updated (recipient_id, updated_at, actor_id)
+    INDEX idx_recipient_updated (recipient_id, updated_at, actor_id),
+    INDEX idx_recipient_ranked (recipient_id, liked, super_liked, updated_at, actor_id),
+    INDEX idx_actor_updated (actor_id, updated_at, recipient_id)
 
-);
\ No newline at end of file
+);
+
+CREATE TABLE user_blocks (
+    blocker_id VARCHAR(255) NOT NULL,
+    blocked_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    INDEX idx_blocker_created (blocker_id, created_at, blocked_id)
+
+);
+
+
+CREATE TABLE user_unmatches (
+    unmatcher_id VARCHAR(255) NOT NULL,
+    unmatched_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (unmatcher_id, unmatched_id)
+
+);
+
+CREATE TABLE user_entitlements (
+    user_id VARCHAR(255) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id)
+
+);
+
+CREATE TABLE user_decision_history (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    actor_id VARCHAR(255) NOT NULL,
+    recipient_id VARCHAR(255) NOT NULL,
+    -- The decision in place after the change, NULL if the decision was removed
+    decision_type ENUM('pass', 'like', 'super_like') NULL,
+    `change` ENUM('put', 'undo', 'block', 'unmatch') NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    INDEX idx_actor_recipient_created (actor_id, recipient_id, created_at, id),
+    INDEX idx_actor_created (actor_id, created_at)
+
+);
+
+CREATE TABLE idempotency_keys (
+    user_id VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    -- Hash of the request the key was first used for
+    request_hash BINARY(32) NOT NULL,
+    -- NULL while the request that claimed the key is still running
+    response BLOB NULL,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, idempotency_key),
+    INDEX idx_expires (expires_at)
+
+);
+
+CREATE TABLE decision_outbox (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    event_type ENUM('like', 'pass', 'match') NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    recipient_id VARCHAR(255) NOT NULL,
+    -- The event's position among the recipient's events
+    sequence BIGINT NOT NULL DEFAULT 0,
+    -- The decision the actor made, NULL for match events
+    decision_type ENUM('pass', 'like', 'super_like') NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    -- Set once the relay has published the event
+    published_at TIMESTAMP(6) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_unpublished (published_at, id)
+
+);
+
+-- The last sequence given to each recipient's outbox events. Writing an event locks the row until the transaction
+-- commits, so the recipient's events commit in order
+CREATE TABLE decision_outbox_sequences (
+    recipient_id VARCHAR(255) NOT NULL,
+    last_sequence BIGINT NOT NULL,
+    PRIMARY KEY (recipient_id)
+
+);
+
+CREATE TABLE webhook_endpoints (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    url VARCHAR(2048) NOT NULL,
+    -- Signs the payloads delivered to the endpoint
+    secret VARBINARY(64) NOT NULL,
+    event_types SET('like', 'match') NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+
+);
+
+CREATE TABLE webhook_dead_letters (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    endpoint_id BIGINT NOT NULL,
+    -- The decision_outbox event that wasn't delivered
+    event_id BIGINT NOT NULL,
+    payload BLOB NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- Set once a replay delivers the event
+    replayed_at TIMESTAMP NULL,
+    PRIMARY KEY (id),
+    INDEX idx_pending (replayed_at, endpoint_id, id)
+
+);
+
+CREATE TABLE webhook_deliveries (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    endpoint_id BIGINT NOT NULL,
+    -- The decision_outbox event being delivered
+    event_id BIGINT NOT NULL,
+    payload BLOB NOT NULL,
+    -- How many attempts have failed so far
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    next_attempt_at TIMESTAMP(6) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY uniq_endpoint_event (endpoint_id, event_id),
+    INDEX idx_next_attempt (next_attempt_at, id)
+
+);
@@ -0,0 +1,12 @@
+package entity
+
+import (
+	"time"
+)
+
+// Block is a user hiding another user from themselves, in both directions
+type Block struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt time.Time
+}
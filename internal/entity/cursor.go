@@ -1,92 +1,110 @@
 package entity
 
 import (
-	"encoding/base64"
-	"encoding/json"
-	"errors"
-	"fmt"
+	"slices"
+	"strconv"
 	"time"
 )
 
+// CursorDirection is the direction to page in from a cursor
+type CursorDirection int
+
+const (
+	// CursorNext pages towards older items
+	CursorNext CursorDirection = iota
+	// CursorPrevious pages towards newer items
+	CursorPrevious
+)
+
+// Cursor points at the last item of a page by its timestamp, with the ID of the user or entry it's about to break ties.
+// Lists that rank super-likes above plain likes also carry whether the item is a super-like.
 type Cursor struct {
+	SuperLike bool
 	UpdatedAt time.Time
 	ActorId   string
+	Direction CursorDirection
 }
 
-func DecodeCursor(cursor string) (*Cursor, error) {
-	// Decode base64-encoded cursor string
-	data, err := base64.StdEncoding.DecodeString(cursor)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+// Page is a single page of results, newest first, along with the cursors to the pages either side of it
+type Page[T any] struct {
+	Items          []T
+	NextCursor     *Cursor
+	PreviousCursor *Cursor
+}
+
+// NewPage builds a page from items fetched with a limit of limit+1 from the given cursor.
+// Items fetched with a previous cursor arrive oldest first and are reversed so every page is newest first.
+func NewPage[T any](items []T, limit int, cursor *Cursor, cursorAt func(T) Cursor) *Page[T] {
+	hasMore := len(items) > limit
+	if hasMore {
+		// Trim results to the requested limit
+		items = items[:limit]
 	}
 
-	// Unmarshal JSON into a map
-	var dataMap map[string]interface{}
-	if err := json.Unmarshal(data, &dataMap); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cursor data: %w", err)
+	backwards := cursor != nil && cursor.Direction == CursorPrevious
+	if backwards {
+		slices.Reverse(items)
 	}
 
-	// Extract "updated_at" field
-	updatedAtStr, ok := dataMap["updated_at"].(string)
-	if !ok {
-		return nil, errors.New("missing or invalid 'updated_at' field")
+	page := &Page[T]{Items: items}
+	if len(items) == 0 {
+		return page
 	}
 
-	// Parse the timestamp
-	updatedAt, err := time.Parse("2006-01-02 15:04:05", updatedAtStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid 'updated_at' format: %w", err)
+	// There are older items if we came back from them, or if there were more than we could return
+	if backwards || hasMore {
+		next := cursorAt(items[len(items)-1])
+		next.Direction = CursorNext
+		page.NextCursor = &next
 	}
 
-	// Extract "actor_id" field
-	actorId, ok := dataMap["actor_id"].(string)
-	if !ok {
-		return nil, errors.New("missing or invalid 'actor_id' field")
+	// There are newer items if we paged forwards to get here, or if there were more than we could return
+	if (cursor != nil && !backwards) || (backwards && hasMore) {
+		previous := cursorAt(items[0])
+		previous.Direction = CursorPrevious
+		page.PreviousCursor = &previous
 	}
 
-	// Construct and return Cursor object
-	return &Cursor{
-		UpdatedAt: updatedAt,
-		ActorId:   actorId,
-	}, nil
+	return page
 }
 
-func EncodeCursor(c *Cursor) (string, error) {
-	if c == nil {
-		return "", errors.New("cursor cannot be nil")
+// LikerCursor returns the cursor pointing at the given liker
+func LikerCursor(liker Liker) Cursor {
+	return Cursor{
+		SuperLike: liker.SuperLike,
+		UpdatedAt: time.Unix(int64(liker.UnixTimestamp), 0),
+		ActorId:   liker.ActorID,
 	}
+}
 
-	// Create a map for the cursor data
-	dataMap := map[string]interface{}{
-		"updated_at": c.UpdatedAt.Format("2006-01-02 15:04:05"),
-		"actor_id":   c.ActorId,
+// MatchCursor returns the cursor pointing at the given match
+func MatchCursor(match Match) Cursor {
+	return Cursor{
+		UpdatedAt: time.Unix(int64(match.UnixTimestamp), 0),
+		ActorId:   match.UserID,
 	}
+}
 
-	// Marshal to JSON
-	data, err := json.Marshal(dataMap)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal cursor data: %w", err)
+// DecisionCursor returns the cursor pointing at the given decision in its actor's list of decisions
+func DecisionCursor(decision Decision) Cursor {
+	return Cursor{
+		UpdatedAt: decision.UpdatedAt,
+		ActorId:   decision.RecipientID,
 	}
-
-	// Encode as base64
-	return base64.StdEncoding.EncodeToString(data), nil
 }
 
-func CreateNextCursor(likers []Liker, limit int) ([]Liker, *Cursor) {
-	// If we have more results than the limit, we need to create a cursor
-	if len(likers) > limit {
-		lastLiker := likers[limit-1]
-
-		// Create cursor for the next page
-		nextCursor := &Cursor{
-			UpdatedAt: time.Unix(int64(lastLiker.UnixTimestamp), 0),
-			ActorId:   lastLiker.ActorID,
-		}
-
-		// Trim results to the requested limit
-		return likers[:limit], nextCursor
+// BlockCursor returns the cursor pointing at the given block in its blocker's list of blocks
+func BlockCursor(block Block) Cursor {
+	return Cursor{
+		UpdatedAt: block.CreatedAt,
+		ActorId:   block.BlockedID,
 	}
+}
 
-	// No need for a cursor if we have fewer results than the limit
-	return likers, nil
+// DecisionHistoryCursor returns the cursor pointing at the given entry in the history of a decision
+func DecisionHistoryCursor(entry DecisionHistoryEntry) Cursor {
+	return Cursor{
+		UpdatedAt: entry.CreatedAt,
+		ActorId:   strconv.FormatInt(entry.ID, 10),
+	}
 }
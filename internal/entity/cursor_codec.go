@@ -0,0 +1,320 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ErrInvalidCursor is returned for pagination tokens that are malformed, altered, expired or issued for another list
+var ErrInvalidCursor = errors.New("invalid pagination token")
+
+// cursorVersion1 tokens are a version byte followed by a protobuf encoded payload and an HMAC-SHA256 of both,
+// encoded as URL-safe base64. Add a new version rather than changing the meaning of an existing field.
+const cursorVersion1 byte = 1
+
+// Field numbers of the version 1 payload
+const (
+	cursorFieldKeyID     protowire.Number = 1
+	cursorFieldUpdatedAt protowire.Number = 2 // Unix microseconds, zigzag encoded
+	cursorFieldActorID   protowire.Number = 3
+	cursorFieldDirection protowire.Number = 4
+	cursorFieldExpiresAt protowire.Number = 5 // Unix seconds
+	cursorFieldSuperLike protowire.Number = 6 // Only written when set
+)
+
+// CursorKey is a secret used to sign pagination tokens, the ID is carried in the token so keys can be rotated
+type CursorKey struct {
+	ID     string
+	Secret []byte
+}
+
+// CursorScope binds a pagination token to the list and user it was issued for
+type CursorScope struct {
+	List   string
+	UserID string
+}
+
+// CursorCodec signs pagination tokens with the first of its keys and verifies them with any of them,
+// so a new key can be introduced first and old keys retired once their tokens have expired
+type CursorCodec struct {
+	keys []CursorKey
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+func NewCursorCodec(keys []CursorKey, ttl time.Duration) (*CursorCodec, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one cursor key is required")
+	}
+	for _, key := range keys {
+		if key.ID == "" || strings.Contains(key.ID, ".") {
+			return nil, fmt.Errorf("invalid cursor key id %q", key.ID)
+		}
+		if len(key.Secret) < 32 {
+			return nil, fmt.Errorf("cursor key %q must be at least 32 bytes", key.ID)
+		}
+	}
+	if ttl <= 0 {
+		return nil, errors.New("cursor ttl must be positive")
+	}
+
+	return &CursorCodec{
+		keys: keys,
+		ttl:  ttl,
+		now:  time.Now,
+	}, nil
+}
+
+// cursorPayload is the signed content of a token
+type cursorPayload struct {
+	keyID     string
+	cursor    Cursor
+	expiresAt int64
+}
+
+// DecodeCursor verifies a token issued by EncodeCursor and returns its cursor.
+// Tokens that were altered, have expired or were issued for a different scope are rejected with ErrInvalidCursor.
+func (c *CursorCodec) DecodeCursor(token string, scope CursorScope) (*Cursor, error) {
+	// Legacy tokens are dot separated, which URL-safe base64 never produces
+	if strings.Contains(token, ".") {
+		return c.decodeLegacyCursor(token, scope)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) < 1+sha256.Size {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+	if data[0] != cursorVersion1 {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidCursor, data[0])
+	}
+
+	// Split the token into the signed content and its signature
+	signed, signature := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+
+	payload, err := unmarshalCursorPayload(signed[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	key, ok := c.key(payload.keyID)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key", ErrInvalidCursor)
+	}
+
+	// The scope is signed rather than stored, so a token replayed against another list or user won't verify
+	if !hmac.Equal(sign(key, signed, scopeBytes(scope)), signature) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidCursor)
+	}
+
+	// Check the token hasn't expired
+	if c.now().After(time.Unix(payload.expiresAt, 0)) {
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidCursor)
+	}
+
+	return &payload.cursor, nil
+}
+
+// EncodeCursor returns a signed token for the cursor that is only valid for the given scope until it expires
+func (c *CursorCodec) EncodeCursor(cursor *Cursor, scope CursorScope) (string, error) {
+	if cursor == nil {
+		return "", errors.New("cursor cannot be nil")
+	}
+
+	// Sign with the current key
+	key := c.keys[0]
+	signed := marshalCursorPayload([]byte{cursorVersion1}, cursorPayload{
+		keyID:     key.ID,
+		cursor:    *cursor,
+		expiresAt: c.now().Add(c.ttl).Unix(),
+	})
+
+	token := append(signed, sign(key, signed, scopeBytes(scope))...)
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// key returns the key with the given ID
+func (c *CursorCodec) key(id string) (CursorKey, bool) {
+	for _, key := range c.keys {
+		if key.ID == id {
+			return key, true
+		}
+	}
+	return CursorKey{}, false
+}
+
+// sign returns the HMAC-SHA256 of the message parts under the key
+func sign(key CursorKey, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key.Secret)
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return mac.Sum(nil)
+}
+
+// scopeBytes returns an unambiguous encoding of the scope for signing
+func scopeBytes(scope CursorScope) []byte {
+	b := protowire.AppendString(nil, scope.List)
+	return protowire.AppendString(b, scope.UserID)
+}
+
+// marshalCursorPayload appends the protobuf encoding of the payload to b
+func marshalCursorPayload(b []byte, payload cursorPayload) []byte {
+	b = protowire.AppendTag(b, cursorFieldKeyID, protowire.BytesType)
+	b = protowire.AppendString(b, payload.keyID)
+	b = protowire.AppendTag(b, cursorFieldUpdatedAt, protowire.VarintType)
+	b = protowire.AppendVarint(b, protowire.EncodeZigZag(payload.cursor.UpdatedAt.UnixMicro()))
+	b = protowire.AppendTag(b, cursorFieldActorID, protowire.BytesType)
+	b = protowire.AppendString(b, payload.cursor.ActorId)
+	b = protowire.AppendTag(b, cursorFieldDirection, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(payload.cursor.Direction))
+	b = protowire.AppendTag(b, cursorFieldExpiresAt, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(payload.expiresAt))
+	if payload.cursor.SuperLike {
+		b = protowire.AppendTag(b, cursorFieldSuperLike, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(true))
+	}
+	return b
+}
+
+// unmarshalCursorPayload decodes a protobuf encoded payload, skipping fields it doesn't know
+func unmarshalCursorPayload(b []byte) (cursorPayload, error) {
+	var payload cursorPayload
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return cursorPayload{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == cursorFieldKeyID && typ == protowire.BytesType:
+			payload.keyID, n = protowire.ConsumeString(b)
+		case num == cursorFieldActorID && typ == protowire.BytesType:
+			payload.cursor.ActorId, n = protowire.ConsumeString(b)
+		case num == cursorFieldUpdatedAt && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			payload.cursor.UpdatedAt = time.UnixMicro(protowire.DecodeZigZag(v)).UTC()
+		case num == cursorFieldDirection && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			payload.cursor.Direction = CursorDirection(v)
+		case num == cursorFieldExpiresAt && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			payload.expiresAt = int64(v)
+		case num == cursorFieldSuperLike && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			payload.cursor.SuperLike = protowire.DecodeBool(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return cursorPayload{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+
+	if payload.cursor.Direction != CursorNext && payload.cursor.Direction != CursorPrevious {
+		return cursorPayload{}, fmt.Errorf("invalid direction %d", payload.cursor.Direction)
+	}
+	return payload, nil
+}
+
+// decodeLegacyCursor verifies a token in the signed JSON format used before version 1.
+// Deprecated: this is kept for one release so clients holding these tokens don't break, remove it afterwards.
+func (c *CursorCodec) decodeLegacyCursor(token string, scope CursorScope) (*Cursor, error) {
+	// Split the token into its key ID, payload and signature
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+	keyID, payload, signature := parts[0], parts[1], parts[2]
+
+	key, ok := c.key(keyID)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key", ErrInvalidCursor)
+	}
+
+	// Verify the signature before trusting anything in the payload
+	expected := sign(key, []byte(keyID+"."+payload))
+	actual, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidCursor)
+	}
+
+	// Decode base64-encoded cursor payload
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	// Unmarshal JSON into a map
+	var dataMap map[string]interface{}
+	if err := json.Unmarshal(data, &dataMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursor data: %w", err)
+	}
+
+	// Check the token was issued for this list and user
+	list, _ := dataMap["list"].(string)
+	recipientID, _ := dataMap["recipient_id"].(string)
+	if list != scope.List || recipientID != scope.UserID {
+		return nil, fmt.Errorf("%w: issued for a different list", ErrInvalidCursor)
+	}
+
+	// Check the token hasn't expired
+	expiresAt, ok := dataMap["expires_at"].(float64)
+	if !ok {
+		return nil, errors.New("missing or invalid 'expires_at' field")
+	}
+	if c.now().After(time.Unix(int64(expiresAt), 0)) {
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidCursor)
+	}
+
+	// Extract "updated_at" field
+	updatedAtStr, ok := dataMap["updated_at"].(string)
+	if !ok {
+		return nil, errors.New("missing or invalid 'updated_at' field")
+	}
+
+	// Parse the timestamp
+	updatedAt, err := time.Parse("2006-01-02 15:04:05", updatedAtStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'updated_at' format: %w", err)
+	}
+
+	// Extract "actor_id" field
+	actorId, ok := dataMap["actor_id"].(string)
+	if !ok {
+		return nil, errors.New("missing or invalid 'actor_id' field")
+	}
+
+	// Extract the optional "direction" field, tokens without one page forwards
+	direction := CursorNext
+	if directionStr, ok := dataMap["direction"].(string); ok {
+		switch directionStr {
+		case "next":
+			direction = CursorNext
+		case "previous":
+			direction = CursorPrevious
+		default:
+			return nil, fmt.Errorf("invalid 'direction' field: %q", directionStr)
+		}
+	}
+
+	// Construct and return Cursor object
+	return &Cursor{
+		UpdatedAt: updatedAt,
+		ActorId:   actorId,
+		Direction: direction,
+	}, nil
+}
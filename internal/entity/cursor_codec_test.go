@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCursorKey(id string, fill byte) CursorKey {
+	return CursorKey{ID: id, Secret: bytes.Repeat([]byte{fill}, 32)}
+}
+
+func TestCursorCodec(t *testing.T) {
+	scope := CursorScope{List: "/ExploreService/ListLikedYou", UserID: "recipient1"}
+	cursor := &Cursor{
+		UpdatedAt: time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+		ActorId:   "actor3",
+		Direction: CursorPrevious,
+	}
+
+	codec, err := NewCursorCodec([]CursorKey{testCursorKey("k1", 1)}, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		decoded, err := codec.DecodeCursor(token, scope)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("RoundTripSuperLike", func(t *testing.T) {
+		superLike := &Cursor{UpdatedAt: cursor.UpdatedAt, ActorId: "actor3", SuperLike: true}
+
+		token, err := codec.EncodeCursor(superLike, scope)
+		require.NoError(t, err)
+
+		decoded, err := codec.DecodeCursor(token, scope)
+		require.NoError(t, err)
+		assert.Equal(t, superLike, decoded)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		// Flip a bit of the actor ID in the payload
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		require.NoError(t, err)
+		i := bytes.Index(data, []byte("actor3"))
+		require.Positive(t, i)
+		data[i+5] ^= 1
+
+		_, err = codec.DecodeCursor(base64.RawURLEncoding.EncodeToString(data), scope)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		require.NoError(t, err)
+		data[0] = 2
+
+		_, err = codec.DecodeCursor(base64.RawURLEncoding.EncodeToString(data), scope)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("URLSafeWithSubSecondPrecision", func(t *testing.T) {
+		precise := &Cursor{UpdatedAt: time.Date(2025, 1, 10, 12, 0, 0, 123456000, time.UTC), ActorId: "actor3"}
+
+		token, err := codec.EncodeCursor(precise, scope)
+		require.NoError(t, err)
+		assert.Equal(t, url.QueryEscape(token), token)
+
+		decoded, err := codec.DecodeCursor(token, scope)
+		require.NoError(t, err)
+		assert.Equal(t, precise.UpdatedAt, decoded.UpdatedAt)
+	})
+
+	t.Run("LegacyToken", func(t *testing.T) {
+		// A token in the signed JSON format issued by the previous release
+		payload := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(
+			`{"actor_id":"actor3","direction":"previous","expires_at":%d,"list":"%s","recipient_id":"%s","updated_at":"2025-01-10 12:00:00"}`,
+			time.Now().Add(time.Hour).Unix(), scope.List, scope.UserID)))
+		signed := "k1." + payload
+		token := signed + "." + base64.RawURLEncoding.EncodeToString(sign(testCursorKey("k1", 1), []byte(signed)))
+
+		decoded, err := codec.DecodeCursor(token, scope)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+
+		_, err = codec.DecodeCursor(token, CursorScope{List: scope.List, UserID: "recipient2"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("DifferentScope", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		_, err = codec.DecodeCursor(token, CursorScope{List: scope.List, UserID: "recipient2"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = codec.DecodeCursor(token, CursorScope{List: "/ExploreService/ListNewLikedYou", UserID: scope.UserID})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		expired := *codec
+		expired.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+		_, err = expired.DecodeCursor(token, scope)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("KeyRotation", func(t *testing.T) {
+		token, err := codec.EncodeCursor(cursor, scope)
+		require.NoError(t, err)
+
+		// Tokens signed with the old key are accepted until it's removed
+		rotated, err := NewCursorCodec([]CursorKey{testCursorKey("k2", 2), testCursorKey("k1", 1)}, time.Hour)
+		require.NoError(t, err)
+		_, err = rotated.DecodeCursor(token, scope)
+		require.NoError(t, err)
+
+		retired, err := NewCursorCodec([]CursorKey{testCursorKey("k2", 2)}, time.Hour)
+		require.NoError(t, err)
+		_, err = retired.DecodeCursor(token, scope)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
@@ -1,18 +1,171 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 )
 
+// DecisionFilter selects which of an actor's decisions to list
+type DecisionFilter int
+
+const (
+	DecisionFilterAll DecisionFilter = iota
+	DecisionFilterLiked
+	DecisionFilterPassed
+)
+
+// DecisionType is what an actor decided about a recipient
+type DecisionType int
+
+const (
+	DecisionTypePass DecisionType = iota
+	DecisionTypeLike
+	// DecisionTypeSuperLike is a like that stands out to the recipient
+	DecisionTypeSuperLike
+)
+
+// Liked reports whether the decision is any kind of like
+func (t DecisionType) Liked() bool {
+	return t == DecisionTypeLike || t == DecisionTypeSuperLike
+}
+
 type Decision struct {
 	ActorID     string
 	RecipientID string
-	Liked       bool
+	Type        DecisionType
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// Liked reports whether the actor likes the recipient
+func (d Decision) Liked() bool {
+	return d.Type.Liked()
+}
+
+// DecisionChange is what changed an actor's decision about a recipient
+type DecisionChange int
+
+const (
+	// DecisionChangePut is the actor making a decision
+	DecisionChangePut DecisionChange = iota
+	// DecisionChangeUndo is the actor undoing their most recent decision
+	DecisionChangeUndo
+	// DecisionChangeBlock is the actor's like being turned into a pass by them blocking the recipient
+	DecisionChangeBlock
+	// DecisionChangeUnmatch is the actor's like being turned into a pass by them unmatching the recipient
+	DecisionChangeUnmatch
+)
+
+// DecisionHistoryEntry is one change to an actor's decision about a recipient
+type DecisionHistoryEntry struct {
+	ID          int64
+	ActorID     string
+	RecipientID string
+	// Type is the decision in place after the change, nil if the decision was removed
+	Type      *DecisionType
+	Change    DecisionChange
+	CreatedAt time.Time
+}
+
+// Relationship is the decisions two users have made about each other
+type Relationship struct {
+	UserAID string
+	UserBID string
+	// AToB is user A's decision about user B, nil if they haven't made one
+	AToB *Decision
+	// BToA is user B's decision about user A, nil if they haven't made one
+	BToA *Decision
+}
+
+// Matched reports whether both users like each other
+func (r Relationship) Matched() bool {
+	return r.AToB != nil && r.AToB.Liked() && r.BToA != nil && r.BToA.Liked()
+}
+
+// PendingDecision is a decision an actor wants to record about a recipient
+type PendingDecision struct {
+	RecipientID string
+	Type        DecisionType
+}
+
+// DecisionOutcome is the outcome of recording an actor's decision about a recipient
+type DecisionOutcome struct {
+	// DecidedAt is when the decision was recorded
+	DecidedAt time.Time
+	// Matched is set if both users like each other
+	Matched bool
+	// NewMatch is set if this decision formed the match, rather than the users having already matched
+	NewMatch bool
+	// MatchedAt is when the match formed, zero if the users haven't matched
+	MatchedAt time.Time
+	// PreviousType is the actor's decision before this one, nil if they hadn't decided on the recipient
+	PreviousType *DecisionType
+}
+
+// MatchID returns the ID of the match between two users, the same whichever order they're given in
+func MatchID(userA string, userB string) string {
+	if userB < userA {
+		userA, userB = userB, userA
+	}
+	hash := sha256.Sum256([]byte(userA + "\x00" + userB))
+	return hex.EncodeToString(hash[:16])
+}
+
+// DecisionResult is the outcome of recording one decision of a batch
+type DecisionResult struct {
+	RecipientID string
+	Outcome     DecisionOutcome
+	// Err is set if the decision wasn't recorded
+	Err error
+}
+
+// UndoResult is the outcome of undoing an actor's most recent decision about a recipient
+type UndoResult struct {
+	// Restored is the decision now in place, nil if the actor no longer has a decision about the recipient
+	Restored *DecisionType
+	// MatchDissolved is set if the undone like had formed a match
+	MatchDissolved bool
+}
+
+// LikerFilter narrows down which likers of a recipient to list
+type LikerFilter struct {
+	// Since only keeps likes made at or after this time if set
+	Since *time.Time
+	// Until only keeps likes made before this time if set
+	Until *time.Time
+	// Unanswered only keeps likers the recipient hasn't liked or passed on
+	Unanswered bool
+}
+
 type Liker struct {
 	ActorID       string
 	UnixTimestamp uint64
+	// SuperLike is set if the liker super-liked the recipient, these sort above plain likes
+	SuperLike bool
+}
+
+// Match is a user who has mutually liked another user
+type Match struct {
+	UserID string
+	// UnixTimestamp is when the match formed
+	UnixTimestamp uint64
+}
+
+// LikeEvent is pushed to a user watching for likes, with exactly one of its fields set
+type LikeEvent struct {
+	// Liker is set when someone liked the user
+	Liker *Liker
+	// Match is set when a like between the user and someone else became mutual
+	Match *Match
+}
+
+// LikeSummary counts the users who liked a recipient
+type LikeSummary struct {
+	// Total is every user who liked the recipient
+	Total uint64
+	// New is the users the recipient hasn't liked or passed on in return
+	New uint64
+	// Matches is the users the recipient has liked in return
+	Matches uint64
 }
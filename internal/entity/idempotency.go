@@ -0,0 +1,11 @@
+package entity
+
+// StoredResponse is the response to a request made with an idempotency key, kept to be returned when the request is replayed
+type StoredResponse struct {
+	// RequestHash identifies the request the key was first used for, so the key can't be reused for a different one
+	RequestHash []byte
+	// Response is the encoded response
+	Response []byte
+	// Pending is set while the request that claimed the key is still running, and there's no response yet
+	Pending bool
+}
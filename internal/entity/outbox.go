@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+)
+
+// OutboxEventType is what happened in an event for downstream services
+type OutboxEventType int
+
+const (
+	// OutboxEventLike is an actor liking or super-liking a recipient
+	OutboxEventLike OutboxEventType = iota
+	// OutboxEventPass is an actor passing on a recipient
+	OutboxEventPass
+	// OutboxEventMatch is an actor's like of a recipient forming a match
+	OutboxEventMatch
+)
+
+// OutboxEvent is an event waiting in the outbox to be published to downstream services
+type OutboxEvent struct {
+	ID          int64
+	Type        OutboxEventType
+	ActorID     string
+	RecipientID string
+	// Sequence is the event's position among the recipient's events, counting from 1
+	Sequence int64
+	// DecisionType is the decision the actor made, for like and pass events
+	DecisionType *DecisionType
+	CreatedAt    time.Time
+}
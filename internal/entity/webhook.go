@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+)
+
+// WebhookEndpoint is a partner's URL that events are delivered to
+type WebhookEndpoint struct {
+	ID  int64
+	URL string
+	// Secret signs the payloads delivered to the endpoint
+	Secret []byte
+	// EventTypes are the events delivered to the endpoint, likes and matches
+	EventTypes []OutboxEventType
+	CreatedAt  time.Time
+}
+
+// WebhookDelivery is an event queued to be delivered to a webhook endpoint
+type WebhookDelivery struct {
+	ID int64
+	// Endpoint is where the payload is sent, only its ID is needed to queue a delivery
+	Endpoint WebhookEndpoint
+	// EventID is the outbox event being delivered
+	EventID int64
+	// Payload is the body to send
+	Payload []byte
+	// Attempts is how many times delivering the payload has failed so far
+	Attempts int
+}
+
+// DeadLetter is an event that couldn't be delivered to a webhook endpoint, kept so it can be replayed
+type DeadLetter struct {
+	ID         int64
+	EndpointID int64
+	// EventID is the outbox event that wasn't delivered
+	EventID int64
+	// Payload is the body that was sent
+	Payload   []byte
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+}
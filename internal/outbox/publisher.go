@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+// Publisher delivers outbox events to downstream services.
+// An event may be published more than once, so consumers must tolerate duplicates.
+type Publisher interface {
+	Publish(ctx context.Context, event entity.OutboxEvent) error
+}
+
+// Message is how an event is encoded for downstream services
+type Message struct {
+	ID          int64  `json:"id"`
+	Type        string `json:"type"`
+	ActorID     string `json:"actor_id"`
+	RecipientID string `json:"recipient_id"`
+	// Sequence numbers the recipient's events in order, so consumers can spot duplicates and events out of order
+	Sequence     int64     `json:"sequence"`
+	DecisionType string    `json:"decision_type,omitempty"`
+	MatchID      string    `json:"match_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+var eventTypeNames = map[entity.OutboxEventType]string{
+	entity.OutboxEventLike:  "like",
+	entity.OutboxEventPass:  "pass",
+	entity.OutboxEventMatch: "match",
+}
+
+var decisionTypeNames = map[entity.DecisionType]string{
+	entity.DecisionTypePass:      "pass",
+	entity.DecisionTypeLike:      "like",
+	entity.DecisionTypeSuperLike: "super_like",
+}
+
+// NewMessage encodes the event for downstream services
+func NewMessage(event entity.OutboxEvent) Message {
+	message := Message{
+		ID:          event.ID,
+		Type:        eventTypeNames[event.Type],
+		ActorID:     event.ActorID,
+		RecipientID: event.RecipientID,
+		Sequence:    event.Sequence,
+		CreatedAt:   event.CreatedAt,
+	}
+	if event.DecisionType != nil {
+		message.DecisionType = decisionTypeNames[*event.DecisionType]
+	}
+	if event.Type == entity.OutboxEventMatch {
+		message.MatchID = entity.MatchID(event.ActorID, event.RecipientID)
+	}
+	return message
+}
+
+// WriterPublisher publishes events as JSON lines to a writer such as stdout or a file, for local use
+type WriterPublisher struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (p *WriterPublisher) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.encoder.Encode(NewMessage(event)); err != nil {
+		return fmt.Errorf("failed to write event %d: %w", event.ID, err)
+	}
+	return nil
+}
+
+// MultiPublisher publishes each event to every one of its publishers in turn, stopping at the first failure.
+// The publishers before a failure see the event again when it's retried.
+type MultiPublisher []Publisher
+
+func (p MultiPublisher) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
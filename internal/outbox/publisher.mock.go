@@ -0,0 +1,21 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPublisher is a mock implementation of Publisher
+type MockPublisher struct {
+	mock.Mock
+}
+
+// Ensure MockPublisher implements Publisher interface
+var _ Publisher = (*MockPublisher)(nil)
+
+func (m *MockPublisher) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -0,0 +1,94 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/repository"
+)
+
+// Relay publishes the events written to the outbox.
+// Events are only marked published once the publisher accepts them, so they are delivered at least once,
+// and each recipient's events are published in the order they were written, which is the order of their sequences.
+// Only one relay should run at a time, as two would race to publish the same events out of order.
+type Relay struct {
+	repo      repository.OutboxRepository
+	publisher Publisher
+	// batchSize is the most events read from the outbox at once
+	batchSize int
+	// interval is how long to wait before polling again once the outbox is empty
+	interval time.Duration
+}
+
+func NewRelay(repo repository.OutboxRepository, publisher Publisher, batchSize int, interval time.Duration) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		batchSize: batchSize,
+		interval:  interval,
+	}
+}
+
+// Run relays events until the context is cancelled
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		published, err := r.RelayBatch(ctx)
+		if err != nil {
+			log.Printf("failed to relay outbox events: %v", err)
+		}
+
+		// Keep going straight away while there's a backlog
+		if err == nil && published >= r.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// RelayBatch publishes the oldest unpublished events, returning how many were published.
+// Once one of a recipient's events fails, the rest of theirs are held back so they can't overtake it, and the outbox
+// is read again past them so a recipient whose events keep failing can't hold up everyone else's.
+// Held back recipients are tried again in the next batch, and at most batchSize of them are held back per batch.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	var held []string
+	var total int
+	for {
+		events, err := r.repo.ListUnpublished(ctx, held, r.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		heldBefore := len(held)
+		published := make([]int64, 0, len(events))
+		for _, event := range events {
+			if slices.Contains(held[heldBefore:], event.RecipientID) {
+				continue
+			}
+
+			if err := r.publisher.Publish(ctx, event); err != nil {
+				log.Printf("failed to publish outbox event %d, holding back recipient %s: %v", event.ID, event.RecipientID, err)
+				held = append(held, event.RecipientID)
+				continue
+			}
+			published = append(published, event.ID)
+		}
+
+		if err := r.repo.MarkPublished(ctx, published); err != nil {
+			return total, fmt.Errorf("failed to mark %d events published: %w", len(published), err)
+		}
+		total += len(published)
+
+		// Read past the recipients held back in a full page, their events may be hiding everyone else's
+		if len(events) < r.batchSize || len(held) == heldBefore || len(held) >= r.batchSize {
+			return total, nil
+		}
+	}
+}
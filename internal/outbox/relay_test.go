@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayBatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("HoldsBackRecipientAfterFailure", func(t *testing.T) {
+		repo := new(repository.MockOutboxRepository)
+		publisher := new(MockPublisher)
+		relay := NewRelay(repo, publisher, 10, time.Second)
+
+		events := []entity.OutboxEvent{
+			{ID: 1, Type: entity.OutboxEventLike, ActorID: "actor1", RecipientID: "recipient1"},
+			{ID: 2, Type: entity.OutboxEventLike, ActorID: "actor2", RecipientID: "recipient2"},
+			{ID: 3, Type: entity.OutboxEventMatch, ActorID: "actor1", RecipientID: "recipient1"},
+		}
+		repo.On("ListUnpublished", mock.Anything, []string(nil), 10).Return(events, nil)
+		publisher.On("Publish", mock.Anything, events[0]).Return(errors.New("broker unavailable"))
+		publisher.On("Publish", mock.Anything, events[1]).Return(nil)
+		repo.On("MarkPublished", mock.Anything, []int64{2}).Return(nil)
+
+		published, err := relay.RelayBatch(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, published)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, events[2])
+		repo.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("ReadsPastHeldRecipients", func(t *testing.T) {
+		repo := new(repository.MockOutboxRepository)
+		publisher := new(MockPublisher)
+		relay := NewRelay(repo, publisher, 2, time.Second)
+
+		stuck := []entity.OutboxEvent{
+			{ID: 1, Type: entity.OutboxEventLike, ActorID: "actor1", RecipientID: "recipient1"},
+			{ID: 2, Type: entity.OutboxEventMatch, ActorID: "actor1", RecipientID: "recipient1"},
+		}
+		others := []entity.OutboxEvent{{ID: 3, Type: entity.OutboxEventLike, ActorID: "actor2", RecipientID: "recipient2"}}
+		repo.On("ListUnpublished", mock.Anything, []string(nil), 2).Return(stuck, nil)
+		repo.On("ListUnpublished", mock.Anything, []string{"recipient1"}, 2).Return(others, nil)
+		publisher.On("Publish", mock.Anything, stuck[0]).Return(errors.New("broker unavailable"))
+		publisher.On("Publish", mock.Anything, others[0]).Return(nil)
+		repo.On("MarkPublished", mock.Anything, []int64{}).Return(nil)
+		repo.On("MarkPublished", mock.Anything, []int64{3}).Return(nil)
+
+		published, err := relay.RelayBatch(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, published)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, stuck[1])
+		repo.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("MarkPublishedError", func(t *testing.T) {
+		repo := new(repository.MockOutboxRepository)
+		publisher := new(MockPublisher)
+		relay := NewRelay(repo, publisher, 10, time.Second)
+
+		events := []entity.OutboxEvent{{ID: 1, Type: entity.OutboxEventPass, ActorID: "actor1", RecipientID: "recipient1"}}
+		repo.On("ListUnpublished", mock.Anything, []string(nil), 10).Return(events, nil)
+		publisher.On("Publish", mock.Anything, events[0]).Return(nil)
+		repo.On("MarkPublished", mock.Anything, []int64{1}).Return(errors.New("database error"))
+
+		_, err := relay.RelayBatch(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to mark 1 events published")
+	})
+}
+
+func TestWriterPublisher(t *testing.T) {
+	var buffer bytes.Buffer
+	publisher := NewWriterPublisher(&buffer)
+
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	superLike := entity.DecisionTypeSuperLike
+	require.NoError(t, publisher.Publish(context.Background(), entity.OutboxEvent{
+		ID: 1, Type: entity.OutboxEventLike, ActorID: "actor1", RecipientID: "recipient1", Sequence: 1, DecisionType: &superLike, CreatedAt: createdAt,
+	}))
+	require.NoError(t, publisher.Publish(context.Background(), entity.OutboxEvent{
+		ID: 2, Type: entity.OutboxEventMatch, ActorID: "actor1", RecipientID: "recipient1", Sequence: 2, CreatedAt: createdAt,
+	}))
+
+	assert.Equal(t, `{"id":1,"type":"like","actor_id":"actor1","recipient_id":"recipient1","sequence":1,"decision_type":"super_like","created_at":"2025-01-10T12:00:00Z"}
+{"id":2,"type":"match","actor_id":"actor1","recipient_id":"recipient1","sequence":2,"match_id":"`+entity.MatchID("actor1", "recipient1")+`","created_at":"2025-01-10T12:00:00Z"}
+`, buffer.String())
+}
@@ -0,0 +1,87 @@
+package pubsub
+
+import (
+	"sync"
+)
+
+// Broker fans messages published on a topic out to every subscriber of that topic, within the process.
+// Publishing never blocks: a subscriber whose buffer is full is dropped, so a slow reader can't hold up publishers.
+type Broker[T any] struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription[T]]struct{}
+	bufferSize  int
+}
+
+// Subscription receives the messages published on a topic until it is unsubscribed or dropped
+type Subscription[T any] struct {
+	broker   *Broker[T]
+	topic    string
+	messages chan T
+	closed   bool
+}
+
+func NewBroker[T any](bufferSize int) *Broker[T] {
+	return &Broker[T]{
+		subscribers: make(map[string]map[*Subscription[T]]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Subscribe starts receiving the messages published on the topic from now on
+func (b *Broker[T]) Subscribe(topic string) *Subscription[T] {
+	subscription := &Subscription[T]{
+		broker:   b,
+		topic:    topic,
+		messages: make(chan T, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[*Subscription[T]]struct{})
+	}
+	b.subscribers[topic][subscription] = struct{}{}
+
+	return subscription
+}
+
+// Publish sends the message to every subscriber of the topic, dropping those that have fallen behind
+func (b *Broker[T]) Publish(topic string, message T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscription := range b.subscribers[topic] {
+		select {
+		case subscription.messages <- message:
+		default:
+			b.remove(subscription)
+		}
+	}
+}
+
+// remove stops delivering to the subscription and closes its channel, the caller must hold the lock
+func (b *Broker[T]) remove(subscription *Subscription[T]) {
+	if subscription.closed {
+		return
+	}
+	subscription.closed = true
+	close(subscription.messages)
+
+	delete(b.subscribers[subscription.topic], subscription)
+	if len(b.subscribers[subscription.topic]) == 0 {
+		delete(b.subscribers, subscription.topic)
+	}
+}
+
+// Messages returns the channel messages are delivered on, it is closed once the subscription ends
+func (s *Subscription[T]) Messages() <-chan T {
+	return s.messages
+}
+
+// Unsubscribe stops the subscription, it is safe to call more than once
+func (s *Subscription[T]) Unsubscribe() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s)
+}
@@ -0,0 +1,47 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker(t *testing.T) {
+	t.Run("DeliversToTopicSubscribers", func(t *testing.T) {
+		broker := NewBroker[string](2)
+		subscription := broker.Subscribe("user1")
+		other := broker.Subscribe("user2")
+
+		broker.Publish("user1", "hello")
+
+		assert.Equal(t, "hello", <-subscription.Messages())
+		assert.Len(t, other.Messages(), 0)
+	})
+
+	t.Run("DropsSlowSubscribers", func(t *testing.T) {
+		broker := NewBroker[string](1)
+		subscription := broker.Subscribe("user1")
+
+		broker.Publish("user1", "first")
+		broker.Publish("user1", "second") // The buffer is full so the subscriber is dropped
+
+		assert.Equal(t, "first", <-subscription.Messages())
+		_, ok := <-subscription.Messages()
+		assert.False(t, ok)
+		assert.Empty(t, broker.subscribers)
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		broker := NewBroker[string](1)
+		subscription := broker.Subscribe("user1")
+
+		subscription.Unsubscribe()
+		subscription.Unsubscribe()
+		broker.Publish("user1", "hello")
+
+		_, ok := <-subscription.Messages()
+		require.False(t, ok)
+		assert.Empty(t, broker.subscribers)
+	})
+}
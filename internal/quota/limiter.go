@@ -0,0 +1,123 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/repository"
+)
+
+// Tier is the level of entitlement a user has paid for
+type Tier string
+
+const (
+	TierFree    Tier = "free"
+	TierPremium Tier = "premium"
+)
+
+// EntitlementLookup finds the tier a user is on
+type EntitlementLookup interface {
+	GetTier(ctx context.Context, userID string) (Tier, error)
+}
+
+// FixedTier puts every user on the same tier, for when there's no entitlement service to ask
+type FixedTier Tier
+
+func (t FixedTier) GetTier(ctx context.Context, userID string) (Tier, error) {
+	return Tier(t), nil
+}
+
+// StoredTier looks up the tier each user has been put on, users who haven't been put on one are on the default tier
+type StoredTier struct {
+	entitlements repository.EntitlementRepository
+	defaultTier  Tier
+}
+
+func NewStoredTier(entitlements repository.EntitlementRepository, defaultTier Tier) StoredTier {
+	return StoredTier{
+		entitlements: entitlements,
+		defaultTier:  defaultTier,
+	}
+}
+
+func (t StoredTier) GetTier(ctx context.Context, userID string) (Tier, error) {
+	tier, err := t.entitlements.GetTier(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if tier == "" {
+		return t.defaultTier, nil
+	}
+	return Tier(tier), nil
+}
+
+// Status is how much of their like quota a user has used
+type Status struct {
+	// Unlimited is true if the user's tier has no like limit, the other fields are unset
+	Unlimited bool
+	Limit     uint32
+	Remaining uint32
+	// ResetAt is when the oldest like in the window leaves it, giving back a like. Zero if there are no likes in the window
+	ResetAt time.Time
+}
+
+// Exhausted reports whether the user can't like anyone until ResetAt
+func (s Status) Exhausted() bool {
+	return !s.Unlimited && s.Remaining == 0
+}
+
+// Limiter limits how many likes each user can make in a rolling window, depending on their tier.
+// Likes are counted from the decision history, so a like that's undone or made again still counts.
+// The check isn't atomic with the decision, so concurrent likes can overshoot the limit slightly.
+type Limiter struct {
+	decisions    repository.DecisionRepository
+	entitlements EntitlementLookup
+	// limits are the likes allowed in the window for each tier, a tier without a limit is unlimited
+	limits map[Tier]uint32
+	window time.Duration
+}
+
+func NewLimiter(decisions repository.DecisionRepository, entitlements EntitlementLookup, limits map[Tier]uint32, window time.Duration) *Limiter {
+	return &Limiter{
+		decisions:    decisions,
+		entitlements: entitlements,
+		limits:       limits,
+		window:       window,
+	}
+}
+
+// Window is how far back likes are counted
+func (l *Limiter) Window() time.Duration {
+	return l.window
+}
+
+// Check returns the user's like quota as of now
+func (l *Limiter) Check(ctx context.Context, userID string) (Status, error) {
+	tier, err := l.entitlements.GetTier(ctx, userID)
+	if err != nil {
+		return Status{}, fmt.Errorf("failed to look up tier: %w", err)
+	}
+
+	limit, ok := l.limits[tier]
+	if !ok {
+		return Status{Unlimited: true}, nil
+	}
+
+	status := Status{Limit: limit, Remaining: limit}
+	if limit == 0 {
+		return status, nil
+	}
+
+	// Only the newest likes up to the limit matter, the oldest of them is the next to leave the window
+	likedAt, err := l.decisions.ListLikeTimesByActor(ctx, userID, time.Now().Add(-l.window), int(limit))
+	if err != nil {
+		return Status{}, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	status.Remaining = limit - uint32(len(likedAt))
+	if len(likedAt) > 0 {
+		status.ResetAt = likedAt[len(likedAt)-1].Add(l.window)
+	}
+	return status, nil
+}
@@ -0,0 +1,93 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	limits := map[Tier]uint32{TierFree: 3}
+
+	t.Run("Remaining", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		limiter := NewLimiter(repo, FixedTier(TierFree), limits, 24*time.Hour)
+
+		oldest := time.Now().Add(-time.Hour)
+		repo.On("ListLikeTimesByActor", mock.Anything, "user1", mock.AnythingOfType("time.Time"), 3).
+			Return([]time.Time{oldest.Add(time.Minute), oldest}, nil)
+
+		status, err := limiter.Check(ctx, "user1")
+
+		require.NoError(t, err)
+		assert.False(t, status.Exhausted())
+		assert.Equal(t, uint32(3), status.Limit)
+		assert.Equal(t, uint32(1), status.Remaining)
+		assert.Equal(t, oldest.Add(24*time.Hour), status.ResetAt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		limiter := NewLimiter(repo, FixedTier(TierFree), limits, 24*time.Hour)
+
+		now := time.Now()
+		repo.On("ListLikeTimesByActor", mock.Anything, "user1", mock.AnythingOfType("time.Time"), 3).
+			Return([]time.Time{now, now.Add(-time.Hour), now.Add(-2 * time.Hour)}, nil)
+
+		status, err := limiter.Check(ctx, "user1")
+
+		require.NoError(t, err)
+		assert.True(t, status.Exhausted())
+		assert.Equal(t, now.Add(22*time.Hour), status.ResetAt)
+	})
+
+	t.Run("UnlimitedTier", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		limiter := NewLimiter(repo, FixedTier(TierPremium), limits, 24*time.Hour)
+
+		status, err := limiter.Check(ctx, "user1")
+
+		require.NoError(t, err)
+		assert.True(t, status.Unlimited)
+		assert.False(t, status.Exhausted())
+		repo.AssertNotCalled(t, "ListLikeTimesByActor", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("DatabaseError", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		limiter := NewLimiter(repo, FixedTier(TierFree), limits, 24*time.Hour)
+
+		repo.On("ListLikeTimesByActor", mock.Anything, "user1", mock.AnythingOfType("time.Time"), 3).
+			Return(nil, errors.New("database error"))
+
+		_, err := limiter.Check(ctx, "user1")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to count likes")
+	})
+}
+
+func TestStoredTier(t *testing.T) {
+	ctx := context.Background()
+	entitlements := new(repository.MockEntitlementRepository)
+	lookup := NewStoredTier(entitlements, TierFree)
+
+	entitlements.On("GetTier", mock.Anything, "user1").Return("premium", nil)
+	entitlements.On("GetTier", mock.Anything, "user2").Return("", nil)
+
+	tier, err := lookup.GetTier(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, TierPremium, tier)
+
+	tier, err = lookup.GetTier(ctx, "user2")
+	require.NoError(t, err)
+	assert.Equal(t, TierFree, tier)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type BlockRepository interface {
+	// Block records that the blocker has blocked the user and dissolves any match between them,
+	// reporting whether there was one
+	Block(ctx context.Context, blockerID string, blockedID string) (bool, error)
+
+	Unblock(ctx context.Context, blockerID string, blockedID string) error
+
+	ListBlockedByUser(ctx context.Context, blockerID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Block], error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBlockRepository is a mock implementation of BlockRepository
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+// Ensure MockBlockRepository implements BlockRepository interface
+var _ BlockRepository = (*MockBlockRepository)(nil)
+
+func (m *MockBlockRepository) Block(ctx context.Context, blockerID string, blockedID string) (bool, error) {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) Unblock(ctx context.Context, blockerID string, blockedID string) error {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Error(0)
+}
+
+func (m *MockBlockRepository) ListBlockedByUser(ctx context.Context, blockerID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Block], error) {
+	args := m.Called(ctx, blockerID, cursor, limit)
+
+	var page *entity.Page[entity.Block]
+	if args.Get(0) != nil {
+		page = args.Get(0).(*entity.Page[entity.Block])
+	}
+
+	return page, args.Error(1)
+}
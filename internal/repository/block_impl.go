@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type BlockRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewBlockRepositoryImpl(db *sql.DB) BlockRepository {
+	return BlockRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r BlockRepositoryImpl) Block(ctx context.Context, blockerID string, blockedID string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	// Blocking again keeps the original block
+	query := `
+		INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+		VALUES (?, ?, NOW())
+		ON DUPLICATE KEY UPDATE blocker_id = blocker_id`
+
+	if _, err := tx.ExecContext(ctx, query, blockerID, blockedID); err != nil {
+		return false, dbErrorf("failed to put block: %w", err)
+	}
+
+	// Check whether the blocked user likes the blocker, in which case a like from the blocker is a match
+	checkQuery := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM user_decisions
+			WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE
+		)`
+
+	var likedBack bool
+	if err := tx.QueryRowContext(ctx, checkQuery, blockedID, blockerID).Scan(&likedBack); err != nil {
+		return false, dbErrorf("failed to check for mutual like: %w", err)
+	}
+
+	// Turn the blocker's like into a pass, so the match stays dissolved once they unblock.
+	// The previous decision is forgotten so the like can't be brought back by undoing it,
+	// and previous_updated_at is kept set so the pass isn't taken for a first decision and deleted by an undo.
+	passQuery := `
+		UPDATE user_decisions
+		SET previous_decision_type = NULL, previous_updated_at = updated_at, decision_type = 'pass', updated_at = NOW()
+		WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE`
+
+	result, err := tx.ExecContext(ctx, passQuery, blockerID, blockedID)
+	if err != nil {
+		return false, dbErrorf("failed to dissolve match: %w", err)
+	}
+	passed, err := result.RowsAffected()
+	if err != nil {
+		return false, dbErrorf("failed to dissolve match: %w", err)
+	}
+	if passed > 0 {
+		pass := entity.DecisionTypePass
+		if err := recordDecisionChange(ctx, tx, blockerID, blockedID, &pass, entity.DecisionChangeBlock); err != nil {
+			return false, err
+		}
+	}
+
+	// A pass that replaced a like must forget it too, or undoing the pass would bring the like back
+	forgetQuery := `
+		UPDATE user_decisions
+		SET previous_decision_type = NULL
+		WHERE actor_id = ? AND recipient_id = ? AND previous_decision_type IS NOT NULL`
+
+	if _, err := tx.ExecContext(ctx, forgetQuery, blockerID, blockedID); err != nil {
+		return false, dbErrorf("failed to forget previous decision: %w", err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return false, dbErrorf("failed to commit transaction: %w", err)
+	}
+
+	return likedBack && passed > 0, nil
+}
+
+func (r BlockRepositoryImpl) Unblock(ctx context.Context, blockerID string, blockedID string) error {
+	query := "DELETE FROM user_blocks WHERE blocker_id = ? AND blocked_id = ?"
+
+	if _, err := r.db.ExecContext(ctx, query, blockerID, blockedID); err != nil {
+		return dbErrorf("failed to delete block: %w", err)
+	}
+
+	return nil
+}
+
+func (r BlockRepositoryImpl) ListBlockedByUser(ctx context.Context, blockerID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Block], error) {
+	query := "SELECT blocker_id, blocked_id, created_at FROM user_blocks WHERE blocker_id = ?"
+	args := []interface{}{blockerID}
+
+	// Apply cursor-based pagination if provided
+	if cursor != nil {
+		query += " AND " + keysetCondition(cursor, "created_at", "blocked_id")
+		args = append(args, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
+	}
+
+	query += " ORDER BY " + keysetOrder(cursor, "created_at", "blocked_id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var blocks []entity.Block
+	for rows.Next() {
+		var block entity.Block
+		if err := rows.Scan(&block.BlockerID, &block.BlockedID, &block.CreatedAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+		blocks = append(blocks, block)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return entity.NewPage(blocks, limit, cursor, entity.BlockCursor), nil
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlock(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewBlockRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	insertSQL := "INSERT INTO user_blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, NOW()) ON DUPLICATE KEY UPDATE blocker_id = blocker_id"
+	checkSQL := "SELECT EXISTS( SELECT 1 FROM user_decisions WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE )"
+	passSQL := "UPDATE user_decisions SET previous_decision_type = NULL, previous_updated_at = updated_at, decision_type = 'pass', updated_at = NOW() WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE"
+	forgetSQL := "UPDATE user_decisions SET previous_decision_type = NULL WHERE actor_id = ? AND recipient_id = ? AND previous_decision_type IS NOT NULL"
+
+	t.Run("Success_DissolvesMatch", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "user2").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(checkSQL).
+			WithArgs("user2", "user1").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+		mock.ExpectExec(passSQL).
+			WithArgs("user1", "user2").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs("user1", "user2", "pass", "block").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(forgetSQL).
+			WithArgs("user1", "user2").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		// Call the method
+		matchDissolved, err := repo.Block(ctx, "user1", "user2")
+
+		// Assert results
+		require.NoError(t, err)
+		assert.True(t, matchDissolved)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_NotLiked", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "user3").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(checkSQL).
+			WithArgs("user3", "user1").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+		mock.ExpectExec(passSQL).
+			WithArgs("user1", "user3").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(forgetSQL).
+			WithArgs("user1", "user3").
+			WillReturnResult(sqlmock.NewResult(0, 1)) // Their pass had replaced a like
+		mock.ExpectCommit()
+
+		// Call the method
+		matchDissolved, err := repo.Block(ctx, "user1", "user3")
+
+		// Assert the blocked user's like alone wasn't a match
+		require.NoError(t, err)
+		assert.False(t, matchDissolved)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("QueryError", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "user4").
+			WillReturnError(errors.New("query error"))
+		mock.ExpectRollback()
+
+		// Call the method
+		_, err := repo.Block(ctx, "user1", "user4")
+
+		// Assert results
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to put block")
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestUnblock(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewBlockRepositoryImpl(db)
+
+	mock.ExpectExec("DELETE FROM user_blocks WHERE blocker_id = ? AND blocked_id = ?").
+		WithArgs("user1", "user2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the method
+	err = repo.Unblock(context.Background(), "user1", "user2")
+
+	// Assert results
+	require.NoError(t, err)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListBlockedByUser(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewBlockRepositoryImpl(db)
+
+	// Define test data
+	limit := 1
+	cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.Cursor{
+		UpdatedAt: cursorTime,
+		ActorId:   "user5",
+	}
+
+	// Setup expected query and response
+	rows := sqlmock.NewRows([]string{"blocker_id", "blocked_id", "created_at"}).
+		AddRow("user1", "user4", cursorTime.Add(-time.Hour)).
+		AddRow("user1", "user3", cursorTime.Add(-2*time.Hour)) // Extra row to test pagination
+
+	expectedSQL := "SELECT blocker_id, blocked_id, created_at FROM user_blocks WHERE blocker_id = ? AND (created_at < ? OR (created_at = ? AND blocked_id < ?)) ORDER BY created_at DESC, blocked_id DESC LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("user1", cursorTime, cursorTime, "user5", limit+1).
+		WillReturnRows(rows)
+
+	// Call the method
+	page, err := repo.ListBlockedByUser(context.Background(), "user1", cursor, limit)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "user4", page.Items[0].BlockedID)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, "user4", page.NextCursor.ActorId)
+	require.NotNil(t, page.PreviousCursor)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
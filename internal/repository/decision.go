@@ -2,15 +2,53 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"github.com/shewitt93/explore_service/internal/entity"
+	"time"
+)
+
+var (
+	// ErrDecisionNotFound is returned when the actor hasn't made a decision about the recipient
+	ErrDecisionNotFound = &Error{Kind: ErrNotFound, Err: errors.New("decision not found")}
+	// ErrUndoWindowExpired is returned when a decision is too old to be undone
+	ErrUndoWindowExpired = errors.New("decision can no longer be undone")
+	// ErrNothingToUndo is returned when a decision has already been undone as far as it can be
+	ErrNothingToUndo = errors.New("no earlier decision to restore")
+	// ErrBlocked is returned for decisions, and undos restoring a like, between users where either has blocked the other
+	ErrBlocked = errors.New("users have blocked each other")
+	// ErrUnmatched is returned for likes, and undos restoring one, between users where either has unmatched the other
+	ErrUnmatched = errors.New("users have unmatched each other")
+	// ErrNotMatched is returned when unmatching users who haven't matched
+	ErrNotMatched = &Error{Kind: ErrNotFound, Err: errors.New("users have not matched")}
 )
 
 type DecisionRepository interface {
-	ListLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error)
+	ListLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error)
 
-	ListNewLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error)
+	ListNewLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error)
 
 	CountLikersByRecipient(ctx context.Context, recipientID string) (uint64, error)
 
-	CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, liked bool) (bool, error)
+	GetLikeSummary(ctx context.Context, recipientID string) (entity.LikeSummary, error)
+
+	CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, decisionType entity.DecisionType) (entity.DecisionOutcome, error)
+
+	CreateOrUpdateDecisions(ctx context.Context, actorID string, decisions []entity.PendingDecision) ([]entity.DecisionResult, error)
+
+	UndoDecision(ctx context.Context, actorID string, recipientID string, window time.Duration) (entity.UndoResult, error)
+
+	GetRelationships(ctx context.Context, userAID string, userBIDs []string) ([]entity.Relationship, error)
+
+	ListMatchesByUser(ctx context.Context, userID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Match], error)
+
+	Unmatch(ctx context.Context, unmatcherID string, unmatchedID string) error
+
+	ClearUnmatch(ctx context.Context, userAID string, userBID string) (bool, error)
+
+	ListDecisionsByActor(ctx context.Context, actorID string, filter entity.DecisionFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Decision], error)
+
+	ListDecisionHistory(ctx context.Context, actorID string, recipientID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.DecisionHistoryEntry], error)
+
+	// ListLikeTimesByActor returns when the actor's latest likes and super likes after the time were made, newest first
+	ListLikeTimesByActor(ctx context.Context, actorID string, since time.Time, limit int) ([]time.Time, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/shewitt93/explore_service/internal/entity"
 	"github.com/stretchr/testify/mock"
@@ -15,44 +16,120 @@ type MockDecisionRepository struct {
 // Ensure MockDecisionRepository implements DecisionRepository interface
 var _ DecisionRepository = (*MockDecisionRepository)(nil)
 
-func (m *MockDecisionRepository) ListLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error) {
-	args := m.Called(ctx, recipientID, cursor, limit)
+func (m *MockDecisionRepository) ListLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error) {
+	args := m.Called(ctx, recipientID, filter, cursor, limit)
 
-	var likers []entity.Liker
+	var page *entity.Page[entity.Liker]
 	if args.Get(0) != nil {
-		likers = args.Get(0).([]entity.Liker)
+		page = args.Get(0).(*entity.Page[entity.Liker])
 	}
 
-	var nextCursor *entity.Cursor
-	if args.Get(1) != nil {
-		nextCursor = args.Get(1).(*entity.Cursor)
+	return page, args.Error(1)
+}
+
+func (m *MockDecisionRepository) ListNewLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error) {
+	args := m.Called(ctx, recipientID, filter, cursor, limit)
+
+	var page *entity.Page[entity.Liker]
+	if args.Get(0) != nil {
+		page = args.Get(0).(*entity.Page[entity.Liker])
 	}
 
-	return likers, nextCursor, args.Error(2)
+	return page, args.Error(1)
+}
+
+func (m *MockDecisionRepository) CountLikersByRecipient(ctx context.Context, recipientID string) (uint64, error) {
+	args := m.Called(ctx, recipientID)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *MockDecisionRepository) GetLikeSummary(ctx context.Context, recipientID string) (entity.LikeSummary, error) {
+	args := m.Called(ctx, recipientID)
+	return args.Get(0).(entity.LikeSummary), args.Error(1)
+}
+
+func (m *MockDecisionRepository) CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, decisionType entity.DecisionType) (entity.DecisionOutcome, error) {
+	args := m.Called(ctx, actorID, recipientID, decisionType)
+	return args.Get(0).(entity.DecisionOutcome), args.Error(1)
 }
 
-func (m *MockDecisionRepository) ListNewLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error) {
-	args := m.Called(ctx, recipientID, cursor, limit)
+func (m *MockDecisionRepository) CreateOrUpdateDecisions(ctx context.Context, actorID string, decisions []entity.PendingDecision) ([]entity.DecisionResult, error) {
+	args := m.Called(ctx, actorID, decisions)
 
-	var likers []entity.Liker
+	var results []entity.DecisionResult
 	if args.Get(0) != nil {
-		likers = args.Get(0).([]entity.Liker)
+		results = args.Get(0).([]entity.DecisionResult)
 	}
 
-	var nextCursor *entity.Cursor
-	if args.Get(1) != nil {
-		nextCursor = args.Get(1).(*entity.Cursor)
+	return results, args.Error(1)
+}
+
+func (m *MockDecisionRepository) UndoDecision(ctx context.Context, actorID string, recipientID string, window time.Duration) (entity.UndoResult, error) {
+	args := m.Called(ctx, actorID, recipientID, window)
+	return args.Get(0).(entity.UndoResult), args.Error(1)
+}
+
+func (m *MockDecisionRepository) GetRelationships(ctx context.Context, userAID string, userBIDs []string) ([]entity.Relationship, error) {
+	args := m.Called(ctx, userAID, userBIDs)
+
+	var relationships []entity.Relationship
+	if args.Get(0) != nil {
+		relationships = args.Get(0).([]entity.Relationship)
 	}
 
-	return likers, nextCursor, args.Error(2)
+	return relationships, args.Error(1)
 }
 
-func (m *MockDecisionRepository) CountLikersByRecipient(ctx context.Context, recipientID string) (uint64, error) {
-	args := m.Called(ctx, recipientID)
-	return args.Get(0).(uint64), args.Error(1)
+func (m *MockDecisionRepository) ListMatchesByUser(ctx context.Context, userID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Match], error) {
+	args := m.Called(ctx, userID, cursor, limit)
+
+	var page *entity.Page[entity.Match]
+	if args.Get(0) != nil {
+		page = args.Get(0).(*entity.Page[entity.Match])
+	}
+
+	return page, args.Error(1)
+}
+
+func (m *MockDecisionRepository) ListDecisionsByActor(ctx context.Context, actorID string, filter entity.DecisionFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Decision], error) {
+	args := m.Called(ctx, actorID, filter, cursor, limit)
+
+	var page *entity.Page[entity.Decision]
+	if args.Get(0) != nil {
+		page = args.Get(0).(*entity.Page[entity.Decision])
+	}
+
+	return page, args.Error(1)
 }
 
-func (m *MockDecisionRepository) CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, liked bool) (bool, error) {
-	args := m.Called(ctx, actorID, recipientID, liked)
+func (m *MockDecisionRepository) Unmatch(ctx context.Context, unmatcherID string, unmatchedID string) error {
+	args := m.Called(ctx, unmatcherID, unmatchedID)
+	return args.Error(0)
+}
+
+func (m *MockDecisionRepository) ClearUnmatch(ctx context.Context, userAID string, userBID string) (bool, error) {
+	args := m.Called(ctx, userAID, userBID)
 	return args.Bool(0), args.Error(1)
 }
+
+func (m *MockDecisionRepository) ListDecisionHistory(ctx context.Context, actorID string, recipientID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.DecisionHistoryEntry], error) {
+	args := m.Called(ctx, actorID, recipientID, cursor, limit)
+
+	var page *entity.Page[entity.DecisionHistoryEntry]
+	if args.Get(0) != nil {
+		page = args.Get(0).(*entity.Page[entity.DecisionHistoryEntry])
+	}
+
+	return page, args.Error(1)
+}
+
+func (m *MockDecisionRepository) ListLikeTimesByActor(ctx context.Context, actorID string, since time.Time, limit int) ([]time.Time, error) {
+	args := m.Called(ctx, actorID, since, limit)
+
+	var likedAt []time.Time
+	if args.Get(0) != nil {
+		likedAt = args.Get(0).([]time.Time)
+	}
+
+	return likedAt, args.Error(1)
+}
@@ -3,10 +3,16 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/shewitt93/explore_service/internal/entity"
+	"strings"
+	"time"
 )
 
+// decisionBatchSize is the most decisions recorded in one transaction by CreateOrUpdateDecisions
+const decisionBatchSize = 100
+
 type DecisionRepositoryImpl struct {
 	db *sql.DB
 }
@@ -17,89 +23,185 @@ func NewDecisionRepositoryImpl(db *sql.DB) DecisionRepository {
 	}
 }
 
-func (r DecisionRepositoryImpl) ListLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error) {
+func (r DecisionRepositoryImpl) ListLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error) {
 
-	baseQuery := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp FROM user_decisions WHERE recipient_id = ? AND liked = TRUE"
+	query := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions" + likerIndexHint(filter) +
+		" WHERE recipient_id = ? AND liked = TRUE AND " +
+		notBlockedCondition("user_decisions.recipient_id", "user_decisions.actor_id") + " AND " +
+		notUnmatchedCondition("user_decisions.recipient_id", "user_decisions.actor_id")
+	args := []interface{}{recipientID}
 
-	var args []interface{}
-	var query string
+	// Only keep likes within the requested time range
+	condition, rangeArgs := likerRangeCondition(filter, "updated_at")
+	query += condition
+	args = append(args, rangeArgs...)
 
-	// Apply cursor pagination if provided
+	// Only keep likers the recipient hasn't decided on if asked to
+	if filter.Unanswered {
+		query += " AND " + unansweredCondition("user_decisions.recipient_id", "user_decisions.actor_id")
+	}
+
+	// Apply cursor pagination if provided, super-likes come first
 	if cursor != nil {
-		query = baseQuery + `
-			AND (updated_at < ? OR (updated_at = ? AND actor_id < ?))
-			ORDER BY updated_at DESC, actor_id DESC
-			LIMIT ?`
-		args = []interface{}{recipientID, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId, limit + 1}
-	} else {
-		query = baseQuery + `
-			ORDER BY updated_at DESC, actor_id DESC
-			LIMIT ?`
-		args = []interface{}{recipientID, limit + 1}
+		query += " AND " + rankedKeysetCondition(cursor, "super_liked", "updated_at", "actor_id")
+		args = append(args, cursor.SuperLike, cursor.SuperLike, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
 	}
 
+	query += " ORDER BY " + rankedKeysetOrder(cursor, "super_liked", "updated_at", "actor_id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
+
 	// Execute the query and get results
 	likers, err := r.executeLikersQuery(ctx, query, args)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Handle pagination only if a cursor was originally provided
-	var nextCursor *entity.Cursor
-	if cursor != nil && len(likers) > limit {
-		likers, nextCursor = entity.CreateNextCursor(likers, limit)
-	} else if len(likers) > limit {
-		// Just trim the results without creating a cursor
-		likers = likers[:limit]
-	}
-
-	return likers, nextCursor, nil
+	return entity.NewPage(likers, limit, cursor, entity.LikerCursor), nil
 }
 
-func (r DecisionRepositoryImpl) ListNewLikersByRecipient(ctx context.Context, recipientID string, cursor *entity.Cursor, limit int) ([]entity.Liker, *entity.Cursor, error) {
-	// Base query excluding mutual likes
+func (r DecisionRepositoryImpl) ListNewLikersByRecipient(ctx context.Context, recipientID string, filter entity.LikerFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Liker], error) {
+	// Base query excluding likers the recipient has decided on, whether they liked or passed on them
 	query := `
-        SELECT d1.actor_id, UNIX_TIMESTAMP(d1.updated_at) as unix_timestamp
-        FROM user_decisions d1
+        SELECT d1.actor_id, UNIX_TIMESTAMP(d1.updated_at) as unix_timestamp, d1.super_liked
+        FROM user_decisions d1` + likerIndexHint(filter) + `
         LEFT JOIN user_decisions d2 
             ON d1.actor_id = d2.recipient_id 
             AND d2.actor_id = d1.recipient_id 
-            AND d2.liked = TRUE
-        WHERE d1.recipient_id = ? AND d1.liked = TRUE AND d2.actor_id IS NULL`
+        WHERE d1.recipient_id = ? AND d1.liked = TRUE AND d2.actor_id IS NULL AND ` +
+		notBlockedCondition("d1.recipient_id", "d1.actor_id") + " AND " + notUnmatchedCondition("d1.recipient_id", "d1.actor_id")
 	args := []interface{}{recipientID}
 
-	// Apply cursor-based pagination if provided
+	// Only keep likes within the requested time range
+	condition, rangeArgs := likerRangeCondition(filter, "d1.updated_at")
+	query += condition
+	args = append(args, rangeArgs...)
+
+	// Apply cursor-based pagination if provided, super-likes come first
 	if cursor != nil {
-		query += ` AND (d1.updated_at < ? OR (d1.updated_at = ? AND d1.actor_id < ?))`
-		args = append(args, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
+		query += " AND " + rankedKeysetCondition(cursor, "d1.super_liked", "d1.updated_at", "d1.actor_id")
+		args = append(args, cursor.SuperLike, cursor.SuperLike, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
 	}
 
-	query += ` ORDER BY d1.updated_at DESC, d1.actor_id DESC LIMIT ?`
-	args = append(args, limit+1) // Fetch one extra to check for next page
+	query += " ORDER BY " + rankedKeysetOrder(cursor, "d1.super_liked", "d1.updated_at", "d1.actor_id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
 
 	// Execute the query and get results
 	likers, err := r.executeLikersQuery(ctx, query, args)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	return entity.NewPage(likers, limit, cursor, entity.LikerCursor), nil
+}
+
+// likerRangeCondition returns the conditions keeping only the likes within the filter's time range, with their arguments.
+// The conditions are prefixed with AND so they can be appended to a WHERE clause, and are empty if there's no range.
+func likerRangeCondition(filter entity.LikerFilter, timeColumn string) (string, []interface{}) {
+	var condition string
+	var args []interface{}
+	if filter.Since != nil {
+		condition += fmt.Sprintf(" AND %s >= ?", timeColumn)
+		args = append(args, *filter.Since)
+	}
+	if filter.Until != nil {
+		condition += fmt.Sprintf(" AND %s < ?", timeColumn)
+		args = append(args, *filter.Until)
+	}
+	return condition, args
+}
+
+// likerIndexHint returns the index hint for listing likers with the filter.
+// A time range is served from idx_recipient_updated so only the likes within it are read before ranking them,
+// otherwise idx_recipient_ranked already returns the likes in order.
+func likerIndexHint(filter entity.LikerFilter) string {
+	if filter.Since == nil && filter.Until == nil {
+		return ""
+	}
+	return " FORCE INDEX (idx_recipient_updated)"
+}
+
+// keysetCondition returns the condition selecting the rows after the cursor in its direction.
+// It takes the cursor's timestamp twice followed by its ID as arguments.
+func keysetCondition(cursor *entity.Cursor, timeColumn string, idColumn string) string {
+	op := "<"
+	if cursor.Direction == entity.CursorPrevious {
+		op = ">"
 	}
+	return fmt.Sprintf("(%[1]s %[3]s ? OR (%[1]s = ? AND %[2]s %[3]s ?))", timeColumn, idColumn, op)
+}
+
+// keysetOrder returns the ordering to read rows in from the cursor, newest first unless paging backwards
+func keysetOrder(cursor *entity.Cursor, timeColumn string, idColumn string) string {
+	if cursor != nil && cursor.Direction == entity.CursorPrevious {
+		return fmt.Sprintf("%s ASC, %s ASC", timeColumn, idColumn)
+	}
+	return fmt.Sprintf("%s DESC, %s DESC", timeColumn, idColumn)
+}
+
+// rankedKeysetCondition is keysetCondition for lists that put super-likes first, ranked by the boolean rank column.
+// It takes whether the cursor is a super-like twice followed by keysetCondition's arguments.
+func rankedKeysetCondition(cursor *entity.Cursor, rankColumn string, timeColumn string, idColumn string) string {
+	op := "<"
+	if cursor.Direction == entity.CursorPrevious {
+		op = ">"
+	}
+	return fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND %[3]s))", rankColumn, op, keysetCondition(cursor, timeColumn, idColumn))
+}
 
-	// Handle pagination only if a cursor was originally provided
-	var nextCursor *entity.Cursor
-	if cursor != nil && len(likers) > limit {
-		likers, nextCursor = entity.CreateNextCursor(likers, limit)
-	} else if len(likers) > limit {
-		// Just trim the results without creating a cursor
-		likers = likers[:limit]
+// rankedKeysetOrder is keysetOrder for lists that put super-likes first
+func rankedKeysetOrder(cursor *entity.Cursor, rankColumn string, timeColumn string, idColumn string) string {
+	if cursor != nil && cursor.Direction == entity.CursorPrevious {
+		return fmt.Sprintf("%s ASC, %s", rankColumn, keysetOrder(cursor, timeColumn, idColumn))
 	}
+	return fmt.Sprintf("%s DESC, %s", rankColumn, keysetOrder(cursor, timeColumn, idColumn))
+}
+
+// decisionTypeValues are the values of the decision_type column
+var decisionTypeValues = map[entity.DecisionType]string{
+	entity.DecisionTypePass:      "pass",
+	entity.DecisionTypeLike:      "like",
+	entity.DecisionTypeSuperLike: "super_like",
+}
+
+// parseDecisionType returns the decision type stored as the value of the decision_type column
+func parseDecisionType(value string) (entity.DecisionType, error) {
+	for decisionType, v := range decisionTypeValues {
+		if v == value {
+			return decisionType, nil
+		}
+	}
+	return 0, dbErrorf("unknown decision type %q", value)
+}
+
+// notBlockedCondition returns the condition excluding rows where either user has blocked the other
+func notBlockedCondition(userColumn string, otherColumn string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM user_blocks b
+		WHERE (b.blocker_id = %[1]s AND b.blocked_id = %[2]s) OR (b.blocker_id = %[2]s AND b.blocked_id = %[1]s)
+	)`, userColumn, otherColumn)
+}
+
+// notUnmatchedCondition returns the condition excluding rows where either user has unmatched the other
+func notUnmatchedCondition(userColumn string, otherColumn string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM user_unmatches u
+		WHERE (u.unmatcher_id = %[1]s AND u.unmatched_id = %[2]s) OR (u.unmatcher_id = %[2]s AND u.unmatched_id = %[1]s)
+	)`, userColumn, otherColumn)
+}
 
-	return likers, nextCursor, nil
+// unansweredCondition returns the condition excluding rows where the user has decided on the other user
+func unansweredCondition(userColumn string, otherColumn string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM user_decisions a
+		WHERE a.actor_id = %s AND a.recipient_id = %s
+	)`, userColumn, otherColumn)
 }
 
 // executeLikersQuery executes the SQL query and transforms the results into entities
 func (r DecisionRepositoryImpl) executeLikersQuery(ctx context.Context, query string, args []interface{}) ([]entity.Liker, error) {
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("database query failed: %w", err)
+		return nil, dbErrorf("database query failed: %w", err)
 	}
 	defer rows.Close()
 
@@ -107,70 +209,768 @@ func (r DecisionRepositoryImpl) executeLikersQuery(ctx context.Context, query st
 	for rows.Next() {
 		var liker entity.Liker
 		var unixTs int64
-		if err := rows.Scan(&liker.ActorID, &unixTs); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+		if err := rows.Scan(&liker.ActorID, &unixTs, &liker.SuperLike); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
 		}
 		liker.UnixTimestamp = uint64(unixTs)
 		likers = append(likers, liker)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating rows: %w", err)
+		return nil, dbErrorf("error iterating rows: %w", err)
 	}
 
 	return likers, nil
 }
 
 func (r DecisionRepositoryImpl) CountLikersByRecipient(ctx context.Context, recipientID string) (uint64, error) {
-	query := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE"
+	query := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND " +
+		notBlockedCondition("user_decisions.recipient_id", "user_decisions.actor_id") + " AND " +
+		notUnmatchedCondition("user_decisions.recipient_id", "user_decisions.actor_id")
 
 	var count uint64
 	err := r.db.QueryRowContext(ctx, query, recipientID).Scan(&count)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count likers: %w", err)
+		return 0, dbErrorf("failed to count likers: %w", err)
 	}
 
 	return count, nil
 }
 
-func (r DecisionRepositoryImpl) CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, liked bool) (bool, error) {
+func (r DecisionRepositoryImpl) GetLikeSummary(ctx context.Context, recipientID string) (entity.LikeSummary, error) {
+	// Count every liker in one pass, splitting out those the recipient hasn't decided on and those they liked back
+	query := `
+        SELECT COUNT(*), COALESCE(SUM(d2.actor_id IS NULL), 0), COALESCE(SUM(d2.liked = TRUE), 0)
+        FROM user_decisions d1
+        LEFT JOIN user_decisions d2
+            ON d1.actor_id = d2.recipient_id
+            AND d2.actor_id = d1.recipient_id
+        WHERE d1.recipient_id = ? AND d1.liked = TRUE AND ` +
+		notBlockedCondition("d1.recipient_id", "d1.actor_id") + " AND " + notUnmatchedCondition("d1.recipient_id", "d1.actor_id")
+
+	var summary entity.LikeSummary
+	err := r.db.QueryRowContext(ctx, query, recipientID).Scan(&summary.Total, &summary.New, &summary.Matches)
+	if err != nil {
+		return entity.LikeSummary{}, dbErrorf("failed to summarise likers: %w", err)
+	}
+
+	return summary, nil
+}
+
+func (r DecisionRepositoryImpl) CreateOrUpdateDecision(ctx context.Context, actorID string, recipientID string, decisionType entity.DecisionType) (entity.DecisionOutcome, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.DecisionOutcome{}, dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := lockOutboxRecipients(ctx, tx, []string{recipientID}); err != nil {
+		return entity.DecisionOutcome{}, err
+	}
+
+	outcome, err := r.putDecision(ctx, tx, actorID, recipientID, decisionType)
+	if err != nil {
+		return entity.DecisionOutcome{}, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return entity.DecisionOutcome{}, dbErrorf("failed to commit transaction: %w", err)
+	}
+
+	return outcome, nil
+}
+
+// CreateOrUpdateDecisions records the decisions in transactions of up to decisionBatchSize decisions.
+// A decision that fails is rolled back on its own and reported in its result without affecting the others.
+// If the context ends part way through, the transactions already committed stand and only the decisions left are failed.
+func (r DecisionRepositoryImpl) CreateOrUpdateDecisions(ctx context.Context, actorID string, decisions []entity.PendingDecision) ([]entity.DecisionResult, error) {
+	results := make([]entity.DecisionResult, 0, len(decisions))
+	for start := 0; start < len(decisions); start += decisionBatchSize {
+		// Stop starting new transactions once the caller has gone away
+		if err := ctx.Err(); err != nil {
+			remaining := make([]entity.DecisionResult, len(decisions)-start)
+			for i, decision := range decisions[start:] {
+				remaining[i].RecipientID = decision.RecipientID
+			}
+			return append(results, failDecisionResults(remaining, classifyError(err))...), nil
+		}
+
+		end := min(start+decisionBatchSize, len(decisions))
+		results = append(results, r.putDecisionsChunk(ctx, actorID, decisions[start:end])...)
+	}
+
+	return results, nil
+}
+
+// putDecisionsChunk records the decisions in a single transaction, using a savepoint per decision
+func (r DecisionRepositoryImpl) putDecisionsChunk(ctx context.Context, actorID string, decisions []entity.PendingDecision) []entity.DecisionResult {
+	results := make([]entity.DecisionResult, len(decisions))
+	for i, decision := range decisions {
+		results[i].RecipientID = decision.RecipientID
+	}
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return false, fmt.Errorf("failed to begin transaction: %w", err)
+		return failDecisionResults(results, dbErrorf("failed to begin transaction: %w", err))
 	}
 	defer tx.Rollback() // Rollback if not committed
 
-	// Insert or update the decision
+	recipientIDs := make([]string, len(decisions))
+	for i, decision := range decisions {
+		recipientIDs[i] = decision.RecipientID
+	}
+	if err := lockOutboxRecipients(ctx, tx, recipientIDs); err != nil {
+		return failDecisionResults(results, err)
+	}
+
+	for i, decision := range decisions {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT decision"); err != nil {
+			return failDecisionResults(results, dbErrorf("failed to create savepoint: %w", err))
+		}
+
+		outcome, err := r.putDecision(ctx, tx, actorID, decision.RecipientID, decision.Type)
+		if err != nil {
+			results[i].Err = err
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT decision"); err != nil {
+				return failDecisionResults(results, dbErrorf("failed to rollback to savepoint: %w", err))
+			}
+			continue
+		}
+		results[i].Outcome = outcome
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return failDecisionResults(results, dbErrorf("failed to commit transaction: %w", err))
+	}
+
+	return results
+}
+
+// failDecisionResults marks every result that hadn't already failed with the error, as none of them were recorded
+func failDecisionResults(results []entity.DecisionResult, err error) []entity.DecisionResult {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Err = err
+		}
+		results[i].Outcome = entity.DecisionOutcome{}
+	}
+	return results
+}
+
+// checkDecisionAllowed returns ErrBlocked if either user has blocked the other, or ErrUnmatched for a like between
+// users who have unmatched, so they can't match again. The rows are locked for share so a block or unmatch can't land
+// before the transaction commits.
+func checkDecisionAllowed(ctx context.Context, tx *sql.Tx, actorID string, recipientID string, liked bool) error {
+	blockQuery := `
+		SELECT COUNT(*)
+		FROM user_blocks
+		WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)
+		FOR SHARE`
+
+	var blocks int
+	err := tx.QueryRowContext(ctx, blockQuery, actorID, recipientID, recipientID, actorID).Scan(&blocks)
+	if err != nil {
+		return dbErrorf("failed to check for blocks: %w", err)
+	}
+	if blocks > 0 {
+		return ErrBlocked
+	}
+
+	if !liked {
+		return nil
+	}
+
+	unmatchQuery := `
+		SELECT COUNT(*)
+		FROM user_unmatches
+		WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?)
+		FOR SHARE`
+
+	var unmatches int
+	err = tx.QueryRowContext(ctx, unmatchQuery, actorID, recipientID, recipientID, actorID).Scan(&unmatches)
+	if err != nil {
+		return dbErrorf("failed to check for unmatches: %w", err)
+	}
+	if unmatches > 0 {
+		return ErrUnmatched
+	}
+
+	return nil
+}
+
+// putDecision inserts or updates the decision within the transaction, which must have locked the recipient with lockOutboxRecipients and reports what it replaced and whether the users have matched
+func (r DecisionRepositoryImpl) putDecision(ctx context.Context, tx *sql.Tx, actorID string, recipientID string, decisionType entity.DecisionType) (entity.DecisionOutcome, error) {
+	value, ok := decisionTypeValues[decisionType]
+	if !ok {
+		return entity.DecisionOutcome{}, dbErrorf("unknown decision type %d", decisionType)
+	}
+	liked := decisionType.Liked()
+
+	if err := checkDecisionAllowed(ctx, tx, actorID, recipientID, liked); err != nil {
+		return entity.DecisionOutcome{}, err
+	}
+
+	// Insert or update the decision, remembering the one it replaces.
+	// The previous_* assignments must come first as MySQL applies them in order.
 	query := `
-		INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at)
+		INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at)
 		VALUES (?, ?, ?, NOW(), NOW())
-		ON DUPLICATE KEY UPDATE liked = ?, updated_at = NOW()`
+		ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()`
 
-	_, err = tx.ExecContext(ctx, query, actorID, recipientID, liked, liked)
+	_, err := tx.ExecContext(ctx, query, actorID, recipientID, value, value)
 	if err != nil {
-		return false, fmt.Errorf("failed to put decision: %w", err)
+		return entity.DecisionOutcome{}, dbErrorf("failed to put decision: %w", err)
+	}
+
+	if err := recordDecisionChange(ctx, tx, actorID, recipientID, &decisionType, entity.DecisionChangePut); err != nil {
+		return entity.DecisionOutcome{}, err
+	}
+
+	// Tell downstream services about the decision once it commits
+	eventType := entity.OutboxEventPass
+	if liked {
+		eventType = entity.OutboxEventLike
+	}
+	event := entity.OutboxEvent{Type: eventType, ActorID: actorID, RecipientID: recipientID, DecisionType: &decisionType}
+	if err := enqueueOutboxEvent(ctx, tx, event); err != nil {
+		return entity.DecisionOutcome{}, err
+	}
+
+	// Find the decision this one replaced, when this one was made and when it last formed a match
+	ownQuery := `
+		SELECT previous_decision_type, updated_at, matched_at
+		FROM user_decisions
+		WHERE actor_id = ? AND recipient_id = ?`
+
+	var previous sql.NullString
+	var updatedAt time.Time
+	var matchedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, ownQuery, actorID, recipientID).Scan(&previous, &updatedAt, &matchedAt)
+	if err != nil {
+		return entity.DecisionOutcome{}, dbErrorf("failed to read decision: %w", err)
+	}
+
+	outcome := entity.DecisionOutcome{DecidedAt: updatedAt}
+	if previous.Valid {
+		previousType, err := parseDecisionType(previous.String)
+		if err != nil {
+			return entity.DecisionOutcome{}, err
+		}
+		outcome.PreviousType = &previousType
 	}
 
 	// If the decision is a like, check if there's a mutual like
-	mutualLike := false
 	if liked {
 		checkQuery := `
-			SELECT EXISTS(
+			SELECT matched_at
+			FROM user_decisions
+			WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE`
+
+		var likedBackMatchedAt sql.NullTime
+		err = tx.QueryRowContext(ctx, checkQuery, recipientID, actorID).Scan(&likedBackMatchedAt)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return entity.DecisionOutcome{}, dbErrorf("failed to check for mutual like: %w", err)
+		}
+
+		if err == nil {
+			outcome.Matched = true
+			// The match is only new if the actor didn't already like the recipient
+			outcome.NewMatch = outcome.PreviousType == nil || !outcome.PreviousType.Liked()
+
+			if outcome.NewMatch {
+				// This like forms the match, so it keeps the time for repeated likes and ListMatches to report
+				if err := recordMatchTime(ctx, tx, actorID, recipientID, "updated_at"); err != nil {
+					return entity.DecisionOutcome{}, err
+				}
+				outcome.MatchedAt = updatedAt
+			} else {
+				outcome.MatchedAt = latestMatchTime(matchedAt, likedBackMatchedAt)
+			}
+		}
+	}
+
+	if outcome.NewMatch {
+		event := entity.OutboxEvent{Type: entity.OutboxEventMatch, ActorID: actorID, RecipientID: recipientID}
+		if err := enqueueOutboxEvent(ctx, tx, event); err != nil {
+			return entity.DecisionOutcome{}, err
+		}
+	}
+
+	return outcome, nil
+}
+
+// recordMatchTime stores the time the actor's like formed a match with the recipient, given as an SQL expression.
+// Only the like that forms a match has its time set, so the later of the two users' is the time of their current match.
+func recordMatchTime(ctx context.Context, tx *sql.Tx, actorID string, recipientID string, matchedAt string) error {
+	// updated_at is assigned so it isn't bumped by ON UPDATE
+	query := "UPDATE user_decisions SET matched_at = " + matchedAt + ", updated_at = updated_at WHERE actor_id = ? AND recipient_id = ?"
+
+	if _, err := tx.ExecContext(ctx, query, actorID, recipientID); err != nil {
+		return dbErrorf("failed to record match time: %w", err)
+	}
+	return nil
+}
+
+// latestMatchTime returns the later of the times stored on the two users' likes, which is when their current match formed.
+// The other may be left from an earlier match between them.
+func latestMatchTime(a sql.NullTime, b sql.NullTime) time.Time {
+	if !a.Valid || (b.Valid && b.Time.After(a.Time)) {
+		return b.Time
+	}
+	return a.Time
+}
+
+// decisionChangeValues are the values of the change column of user_decision_history
+var decisionChangeValues = map[entity.DecisionChange]string{
+	entity.DecisionChangePut:     "put",
+	entity.DecisionChangeUndo:    "undo",
+	entity.DecisionChangeBlock:   "block",
+	entity.DecisionChangeUnmatch: "unmatch",
+}
+
+// recordDecisionChange appends a change to the actor's decision about the recipient to its history within the transaction.
+// The decision type is nil if the decision was removed.
+func recordDecisionChange(ctx context.Context, tx *sql.Tx, actorID string, recipientID string, decisionType *entity.DecisionType, change entity.DecisionChange) error {
+	var value sql.NullString
+	if decisionType != nil {
+		value = sql.NullString{String: decisionTypeValues[*decisionType], Valid: true}
+	}
+
+	query := `
+		INSERT INTO user_decision_history (actor_id, recipient_id, decision_type, ` + "`change`" + `, created_at)
+		VALUES (?, ?, ?, ?, NOW(6))`
+
+	if _, err := tx.ExecContext(ctx, query, actorID, recipientID, value, decisionChangeValues[change]); err != nil {
+		return dbErrorf("failed to record decision history: %w", err)
+	}
+	return nil
+}
+
+// scanDecision scans a row of actor_id, recipient_id, decision_type, created_at and updated_at into a decision
+func scanDecision(rows *sql.Rows) (entity.Decision, error) {
+	var decision entity.Decision
+	var decisionType string
+	if err := rows.Scan(&decision.ActorID, &decision.RecipientID, &decisionType, &decision.CreatedAt, &decision.UpdatedAt); err != nil {
+		return entity.Decision{}, dbErrorf("failed to scan row: %w", err)
+	}
+
+	var err error
+	if decision.Type, err = parseDecisionType(decisionType); err != nil {
+		return entity.Decision{}, err
+	}
+	return decision, nil
+}
+
+// UndoDecision reverts the actor's most recent decision about the recipient if it was made within the window,
+// restoring the decision it replaced or removing it if it was the first. Any match the decision formed is dissolved.
+func (r DecisionRepositoryImpl) UndoDecision(ctx context.Context, actorID string, recipientID string, window time.Duration) (entity.UndoResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.UndoResult{}, dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	// Lock the decision and find out whether it can be undone
+	query := `
+		SELECT d.liked, d.previous_decision_type, d.previous_updated_at IS NULL AND d.created_at = d.updated_at,
+			d.updated_at >= NOW() - INTERVAL ? SECOND,
+			EXISTS(
 				SELECT 1
-				FROM user_decisions
-				WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE
-			)`
+				FROM user_decisions r
+				WHERE r.actor_id = d.recipient_id AND r.recipient_id = d.actor_id AND r.liked = TRUE
+			)
+		FROM user_decisions d
+		WHERE d.actor_id = ? AND d.recipient_id = ?
+		FOR UPDATE`
+
+	var liked, firstDecision, withinWindow, likedBack bool
+	var previousType sql.NullString
+	err = tx.QueryRowContext(ctx, query, int64(window/time.Second), actorID, recipientID).
+		Scan(&liked, &previousType, &firstDecision, &withinWindow, &likedBack)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.UndoResult{}, ErrDecisionNotFound
+	}
+	if err != nil {
+		return entity.UndoResult{}, dbErrorf("failed to find decision: %w", err)
+	}
+
+	if !withinWindow {
+		return entity.UndoResult{}, ErrUndoWindowExpired
+	}
 
-		err = tx.QueryRowContext(ctx, checkQuery, recipientID, actorID).Scan(&mutualLike)
+	var result entity.UndoResult
+	switch {
+	case previousType.Valid:
+		restored, err := parseDecisionType(previousType.String)
 		if err != nil {
-			return false, fmt.Errorf("failed to check for mutual like: %w", err)
+			return entity.UndoResult{}, err
+		}
+
+		// A like can only be brought back where it could be made now
+		if restored.Liked() {
+			if err := checkDecisionAllowed(ctx, tx, actorID, recipientID, true); err != nil {
+				return entity.UndoResult{}, err
+			}
+		}
+
+		// Restore the decision this one replaced. previous_updated_at is left set, so a restored first decision
+		// isn't taken for one that was never replaced and deleted by a second undo.
+		restoreQuery := `
+			UPDATE user_decisions
+			SET decision_type = previous_decision_type, updated_at = previous_updated_at, previous_decision_type = NULL
+			WHERE actor_id = ? AND recipient_id = ?`
+		if _, err := tx.ExecContext(ctx, restoreQuery, actorID, recipientID); err != nil {
+			return entity.UndoResult{}, dbErrorf("failed to restore decision: %w", err)
+		}
+		// Bringing a like back forms the match again if the recipient likes the actor
+		if restored.Liked() && !liked && likedBack {
+			if err := recordMatchTime(ctx, tx, actorID, recipientID, "NOW()"); err != nil {
+				return entity.UndoResult{}, err
+			}
+		}
+		if err := recordDecisionChange(ctx, tx, actorID, recipientID, &restored, entity.DecisionChangeUndo); err != nil {
+			return entity.UndoResult{}, err
+		}
+		result.Restored = &restored
+	case firstDecision:
+		// There was no decision before this one, and it hasn't been replaced or restored since it was made
+		deleteQuery := "DELETE FROM user_decisions WHERE actor_id = ? AND recipient_id = ?"
+		if _, err := tx.ExecContext(ctx, deleteQuery, actorID, recipientID); err != nil {
+			return entity.UndoResult{}, dbErrorf("failed to delete decision: %w", err)
 		}
+		if err := recordDecisionChange(ctx, tx, actorID, recipientID, nil, entity.DecisionChangeUndo); err != nil {
+			return entity.UndoResult{}, err
+		}
+	default:
+		// The decision has already been undone back to one we no longer know the predecessor of
+		return entity.UndoResult{}, ErrNothingToUndo
 	}
 
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
-		return false, fmt.Errorf("failed to commit transaction: %w", err)
+		return entity.UndoResult{}, dbErrorf("failed to commit transaction: %w", err)
+	}
+
+	// The match is gone from both sides once the like that formed it is no longer in place
+	result.MatchDissolved = liked && likedBack && (result.Restored == nil || !result.Restored.Liked())
+
+	return result, nil
+}
+
+// GetRelationships returns the relationship between user A and each of the users B, in the same order
+func (r DecisionRepositoryImpl) GetRelationships(ctx context.Context, userAID string, userBIDs []string) ([]entity.Relationship, error) {
+	if len(userBIDs) == 0 {
+		return nil, nil
+	}
+
+	// Fetch the decisions in both directions in one query
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(userBIDs)), ", ")
+	query := `
+		SELECT actor_id, recipient_id, decision_type, created_at, updated_at
+		FROM user_decisions
+		WHERE (actor_id = ? AND recipient_id IN (` + placeholders + `))
+			OR (recipient_id = ? AND actor_id IN (` + placeholders + `))`
+
+	args := make([]interface{}, 0, 2*len(userBIDs)+2)
+	args = append(args, userAID)
+	for _, id := range userBIDs {
+		args = append(args, id)
+	}
+	args = append(args, userAID)
+	for _, id := range userBIDs {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	// Index the relationships by user B so the decisions can be slotted in
+	relationships := make([]entity.Relationship, len(userBIDs))
+	byUserB := make(map[string][]int, len(userBIDs))
+	for i, id := range userBIDs {
+		relationships[i] = entity.Relationship{UserAID: userAID, UserBID: id}
+		byUserB[id] = append(byUserB[id], i)
+	}
+
+	for rows.Next() {
+		decision, err := scanDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if decision.ActorID == userAID {
+			for _, i := range byUserB[decision.RecipientID] {
+				relationships[i].AToB = &decision
+			}
+		} else {
+			for _, i := range byUserB[decision.ActorID] {
+				relationships[i].BToA = &decision
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return relationships, nil
+}
+
+func (r DecisionRepositoryImpl) ListMatchesByUser(ctx context.Context, userID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.Match], error) {
+	// The match formed at the later of the times stored on the two likes, either may be unset, see recordMatchTime
+	const matchedAt = "GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at))"
+
+	query := `
+        SELECT d1.actor_id, UNIX_TIMESTAMP(` + matchedAt + `) as unix_timestamp
+        FROM user_decisions d1
+        JOIN user_decisions d2
+            ON d2.actor_id = d1.recipient_id
+            AND d2.recipient_id = d1.actor_id
+            AND d2.liked = TRUE
+        WHERE d1.recipient_id = ? AND d1.liked = TRUE AND ` + notBlockedCondition("d1.recipient_id", "d1.actor_id")
+	args := []interface{}{userID}
+
+	// Apply cursor-based pagination if provided
+	if cursor != nil {
+		query += " AND " + keysetCondition(cursor, matchedAt, "d1.actor_id")
+		args = append(args, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
+	}
+
+	query += " ORDER BY " + keysetOrder(cursor, matchedAt, "d1.actor_id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []entity.Match
+	for rows.Next() {
+		var match entity.Match
+		var unixTs int64
+		if err := rows.Scan(&match.UserID, &unixTs); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+		match.UnixTimestamp = uint64(unixTs)
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return entity.NewPage(matches, limit, cursor, entity.MatchCursor), nil
+}
+
+// Unmatch dissolves the match between the users by turning the unmatcher's like into a pass,
+// and records the unmatch so they stay hidden from each other and can't match again
+func (r DecisionRepositoryImpl) Unmatch(ctx context.Context, unmatcherID string, unmatchedID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	// Lock both likes so the match can't change underneath us
+	query := `
+		SELECT COUNT(*)
+		FROM user_decisions
+		WHERE ((actor_id = ? AND recipient_id = ?) OR (actor_id = ? AND recipient_id = ?)) AND liked = TRUE
+		FOR UPDATE`
+
+	var likes int
+	err = tx.QueryRowContext(ctx, query, unmatcherID, unmatchedID, unmatchedID, unmatcherID).Scan(&likes)
+	if err != nil {
+		return dbErrorf("failed to find match: %w", err)
+	}
+	if likes < 2 {
+		return ErrNotMatched
+	}
+
+	insertQuery := "INSERT INTO user_unmatches (unmatcher_id, unmatched_id, created_at) VALUES (?, ?, NOW())"
+	if _, err := tx.ExecContext(ctx, insertQuery, unmatcherID, unmatchedID); err != nil {
+		return dbErrorf("failed to record unmatch: %w", err)
+	}
+
+	// The previous decision is forgotten so the like can't be brought back by undoing it.
+	// previous_updated_at is kept set so the pass isn't taken for a first decision and deleted by an undo.
+	passQuery := `
+		UPDATE user_decisions
+		SET previous_decision_type = NULL, previous_updated_at = updated_at, decision_type = 'pass', updated_at = NOW()
+		WHERE actor_id = ? AND recipient_id = ?`
+	if _, err := tx.ExecContext(ctx, passQuery, unmatcherID, unmatchedID); err != nil {
+		return dbErrorf("failed to dissolve match: %w", err)
+	}
+
+	pass := entity.DecisionTypePass
+	if err := recordDecisionChange(ctx, tx, unmatcherID, unmatchedID, &pass, entity.DecisionChangeUnmatch); err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return dbErrorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ClearUnmatch removes any unmatch between the users in either direction, reporting whether there was one
+func (r DecisionRepositoryImpl) ClearUnmatch(ctx context.Context, userAID string, userBID string) (bool, error) {
+	query := `
+		DELETE FROM user_unmatches
+		WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?)`
+
+	result, err := r.db.ExecContext(ctx, query, userAID, userBID, userBID, userAID)
+	if err != nil {
+		return false, dbErrorf("failed to delete unmatch: %w", err)
+	}
+
+	cleared, err := result.RowsAffected()
+	if err != nil {
+		return false, dbErrorf("failed to delete unmatch: %w", err)
+	}
+
+	return cleared > 0, nil
+}
+
+func (r DecisionRepositoryImpl) ListDecisionsByActor(ctx context.Context, actorID string, filter entity.DecisionFilter, cursor *entity.Cursor, limit int) (*entity.Page[entity.Decision], error) {
+	// idx_actor_updated returns the actor's decisions already in order, so a page doesn't sort them all
+	query := "SELECT actor_id, recipient_id, decision_type, created_at, updated_at FROM user_decisions WHERE actor_id = ? AND " +
+		notBlockedCondition("user_decisions.actor_id", "user_decisions.recipient_id")
+	args := []interface{}{actorID}
+
+	switch filter {
+	case entity.DecisionFilterLiked:
+		query += " AND liked = TRUE"
+	case entity.DecisionFilterPassed:
+		query += " AND liked = FALSE"
+	}
+
+	// Apply cursor-based pagination if provided
+	if cursor != nil {
+		query += " AND " + keysetCondition(cursor, "updated_at", "recipient_id")
+		args = append(args, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
+	}
+
+	query += " ORDER BY " + keysetOrder(cursor, "updated_at", "recipient_id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var decisions []entity.Decision
+	for rows.Next() {
+		decision, err := scanDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return entity.NewPage(decisions, limit, cursor, entity.DecisionCursor), nil
+}
+
+// ListDecisionHistory returns every change to the actor's decision about the recipient, newest first
+func (r DecisionRepositoryImpl) ListDecisionHistory(ctx context.Context, actorID string, recipientID string, cursor *entity.Cursor, limit int) (*entity.Page[entity.DecisionHistoryEntry], error) {
+	query := "SELECT id, actor_id, recipient_id, decision_type, `change`, created_at FROM user_decision_history WHERE actor_id = ? AND recipient_id = ?"
+	args := []interface{}{actorID, recipientID}
+
+	// Apply cursor-based pagination if provided
+	if cursor != nil {
+		query += " AND " + keysetCondition(cursor, "created_at", "id")
+		args = append(args, cursor.UpdatedAt, cursor.UpdatedAt, cursor.ActorId)
+	}
+
+	query += " ORDER BY " + keysetOrder(cursor, "created_at", "id") + " LIMIT ?"
+	args = append(args, limit+1) // Fetch one extra to check for another page
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []entity.DecisionHistoryEntry
+	for rows.Next() {
+		var entry entity.DecisionHistoryEntry
+		var decisionType sql.NullString
+		var change string
+		if err := rows.Scan(&entry.ID, &entry.ActorID, &entry.RecipientID, &decisionType, &change, &entry.CreatedAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+
+		if decisionType.Valid {
+			parsed, err := parseDecisionType(decisionType.String)
+			if err != nil {
+				return nil, err
+			}
+			entry.Type = &parsed
+		}
+
+		if entry.Change, err = parseDecisionChange(change); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return entity.NewPage(entries, limit, cursor, entity.DecisionHistoryCursor), nil
+}
+
+// parseDecisionChange returns the change stored as the value of the change column
+func parseDecisionChange(value string) (entity.DecisionChange, error) {
+	for change, v := range decisionChangeValues {
+		if v == value {
+			return change, nil
+		}
+	}
+	return 0, dbErrorf("unknown decision change %q", value)
+}
+
+func (r DecisionRepositoryImpl) ListLikeTimesByActor(ctx context.Context, actorID string, since time.Time, limit int) ([]time.Time, error) {
+	query := `
+		SELECT created_at
+		FROM user_decision_history
+		WHERE actor_id = ? AND ` + "`change`" + ` = 'put' AND decision_type IN ('like', 'super_like') AND created_at > ?
+		ORDER BY created_at DESC
+		LIMIT ?`
+
+	rows, err := r.db.QueryContext(ctx, query, actorID, since, limit)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var likedAt []time.Time
+	for rows.Next() {
+		var createdAt time.Time
+		if err := rows.Scan(&createdAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+		likedAt = append(likedAt, createdAt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
 	}
 
-	return mutualLike, nil
+	return likedAt, nil
 }
@@ -12,6 +12,30 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// historySQL records a change to a decision in its history
+const historySQL = "INSERT INTO user_decision_history (actor_id, recipient_id, decision_type, `change`, created_at) VALUES (?, ?, ?, ?, NOW(6))"
+
+// lockRecipientSQL locks the outbox sequence of the recipient of a single decision
+const lockRecipientSQL = "INSERT INTO decision_outbox_sequences (recipient_id, last_sequence) VALUES (?, 0) ON DUPLICATE KEY UPDATE last_sequence = last_sequence"
+
+// sequenceSQL numbers the recipient's next outbox event
+const sequenceSQL = "UPDATE decision_outbox_sequences SET last_sequence = last_sequence + 1 WHERE recipient_id = ?"
+
+// outboxSQL writes an event for downstream services to the outbox
+const outboxSQL = "INSERT INTO decision_outbox (event_type, actor_id, recipient_id, sequence, decision_type, created_at) SELECT ?, ?, recipient_id, last_sequence, ?, NOW(6) FROM decision_outbox_sequences WHERE recipient_id = ?"
+
+// readDecisionSQL reads back the decision this one replaced, when this one was made and when it last formed a match
+const readDecisionSQL = "SELECT previous_decision_type, updated_at, matched_at FROM user_decisions WHERE actor_id = ? AND recipient_id = ?"
+
+// readDecisionColumns are the columns read back by readDecisionSQL
+var readDecisionColumns = []string{"previous_decision_type", "updated_at", "matched_at"}
+
+// checkMutualSQL reads whether the recipient liked the actor back and when their like last formed a match
+const checkMutualSQL = "SELECT matched_at FROM user_decisions WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE"
+
+// matchTimeSQL stores when the actor's like formed a match
+const matchTimeSQL = "UPDATE user_decisions SET matched_at = updated_at, updated_at = updated_at WHERE actor_id = ? AND recipient_id = ?"
+
 func TestListLikersByRecipient(t *testing.T) {
 	// Create a new mock database connection with QueryMatcherEqual
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -30,27 +54,27 @@ func TestListLikersByRecipient(t *testing.T) {
 		limit := 10
 
 		// Setup expected query and response
-		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
-			AddRow("actor1", int64(1738754100)).
-			AddRow("actor2", int64(1738686000))
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor1", int64(1738754100), false).
+			AddRow("actor2", int64(1738686000), false)
 
 		// Define expected SQL with args
-		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp FROM user_decisions WHERE recipient_id = ? AND liked = TRUE ORDER BY updated_at DESC, actor_id DESC LIMIT ?"
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(recipientID, limit+1).
 			WillReturnRows(rows)
 
 		// Call the method
-		likers, nextCursor, err := repo.ListLikersByRecipient(ctx, recipientID, nil, limit)
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.Len(t, likers, 2)
-		assert.Equal(t, "actor1", likers[0].ActorID)
-		assert.Equal(t, uint64(1738754100), likers[0].UnixTimestamp)
-		assert.Equal(t, "actor2", likers[1].ActorID)
-		assert.Equal(t, uint64(1738686000), likers[1].UnixTimestamp)
-		assert.Nil(t, nextCursor)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "actor1", page.Items[0].ActorID)
+		assert.Equal(t, uint64(1738754100), page.Items[0].UnixTimestamp)
+		assert.Equal(t, "actor2", page.Items[1].ActorID)
+		assert.Equal(t, uint64(1738686000), page.Items[1].UnixTimestamp)
+		assert.Nil(t, page.NextCursor)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -69,28 +93,205 @@ func TestListLikersByRecipient(t *testing.T) {
 		}
 
 		// Setup expected query and response
-		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
-			AddRow("actor4", int64(1738400000)).
-			AddRow("actor5", int64(1738300000)).
-			AddRow("actor6", int64(1738200000)) // Extra row to test pagination
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor4", int64(1738400000), false).
+			AddRow("actor5", int64(1738300000), false).
+			AddRow("actor6", int64(1738200000), false) // Extra row to test pagination
 
 		// Define expected SQL with args
-		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND (updated_at < ? OR (updated_at = ? AND actor_id < ?)) ORDER BY updated_at DESC, actor_id DESC LIMIT ?"
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) AND (super_liked < ? OR (super_liked = ? AND (updated_at < ? OR (updated_at = ? AND actor_id < ?)))) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(recipientID, false, false, cursorTime, cursorTime, "actor3", limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, cursor, limit)
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "actor4", page.Items[0].ActorID)
+		assert.Equal(t, "actor5", page.Items[1].ActorID)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, time.Unix(1738300000, 0), page.NextCursor.UpdatedAt)
+		assert.Equal(t, "actor5", page.NextCursor.ActorId)
+		assert.Equal(t, entity.CursorNext, page.NextCursor.Direction)
+		require.NotNil(t, page.PreviousCursor)
+		assert.Equal(t, "actor4", page.PreviousCursor.ActorId)
+		assert.Equal(t, entity.CursorPrevious, page.PreviousCursor.Direction)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_WithTimeRangeAndCursor", func(t *testing.T) {
+		// Define test data
+		recipientID := "recipient1"
+		limit := 10
+		since := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+		until := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		cursor := &entity.Cursor{
+			UpdatedAt: cursorTime,
+			ActorId:   "actor3",
+		}
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor4", int64(1736400000), false)
+
+		// Define expected SQL with args, the range is read from idx_recipient_updated and applies alongside the cursor
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions FORCE INDEX (idx_recipient_updated) WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) AND updated_at >= ? AND updated_at < ? AND (super_liked < ? OR (super_liked = ? AND (updated_at < ? OR (updated_at = ? AND actor_id < ?)))) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(recipientID, since, until, false, false, cursorTime, cursorTime, "actor3", limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{Since: &since, Until: &until}, cursor, limit)
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Nil(t, page.NextCursor)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_Unanswered", func(t *testing.T) {
+		// Define test data
+		recipientID := "recipient1"
+		limit := 10
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor1", int64(1738754100), true)
+
+		// Define expected SQL with args, likers the recipient liked or passed on are excluded
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_decisions a WHERE a.actor_id = user_decisions.recipient_id AND a.recipient_id = user_decisions.actor_id ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
 		mock.ExpectQuery(expectedSQL).
-			WithArgs(recipientID, cursorTime, cursorTime, "actor3", limit+1).
+			WithArgs(recipientID, limit+1).
 			WillReturnRows(rows)
 
 		// Call the method
-		likers, nextCursor, err := repo.ListLikersByRecipient(ctx, recipientID, cursor, limit)
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{Unanswered: true}, nil, limit)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.Len(t, likers, 2)
-		assert.Equal(t, "actor4", likers[0].ActorID)
-		assert.Equal(t, "actor5", likers[1].ActorID)
-		assert.NotNil(t, nextCursor)
-		assert.Equal(t, time.Unix(1738300000, 0), nextCursor.UpdatedAt)
-		assert.Equal(t, "actor5", nextCursor.ActorId)
+		assert.Len(t, page.Items, 1)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_FirstPageWithMoreResults", func(t *testing.T) {
+		// Define test data
+		recipientID := "recipient1"
+		limit := 2
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor1", int64(1738754100), false).
+			AddRow("actor2", int64(1738686000), false).
+			AddRow("actor3", int64(1738600000), false) // Extra row to test pagination
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(recipientID, limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
+
+		// Assert results, the first page has no newer page but does have an older one
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, "actor2", page.NextCursor.ActorId)
+		assert.Nil(t, page.PreviousCursor)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_WithPreviousCursor", func(t *testing.T) {
+		// Define test data
+		recipientID := "recipient1"
+		limit := 2
+		cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		cursor := &entity.Cursor{
+			UpdatedAt: cursorTime,
+			ActorId:   "actor3",
+			Direction: entity.CursorPrevious,
+		}
+
+		// Rows come back oldest first when paging backwards
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor2", int64(1738600000), false).
+			AddRow("actor1", int64(1738700000), false).
+			AddRow("actor0", int64(1738800000), false) // Extra row to test pagination
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) AND (super_liked > ? OR (super_liked = ? AND (updated_at > ? OR (updated_at = ? AND actor_id > ?)))) ORDER BY super_liked ASC, updated_at ASC, actor_id ASC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(recipientID, false, false, cursorTime, cursorTime, "actor3", limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, cursor, limit)
+
+		// Assert results are returned newest first
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "actor1", page.Items[0].ActorID)
+		assert.Equal(t, "actor2", page.Items[1].ActorID)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, "actor2", page.NextCursor.ActorId)
+		assert.Equal(t, entity.CursorNext, page.NextCursor.Direction)
+		require.NotNil(t, page.PreviousCursor)
+		assert.Equal(t, "actor1", page.PreviousCursor.ActorId)
+		assert.Equal(t, entity.CursorPrevious, page.PreviousCursor.Direction)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_SuperLikesFirst", func(t *testing.T) {
+		// Define test data
+		recipientID := "recipient1"
+		limit := 1
+
+		// Super-likes come back first, whatever their age
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor1", int64(1738000000), true).
+			AddRow("actor2", int64(1738754100), false) // Extra row to test pagination
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(recipientID, limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
+
+		// Assert the super-like is flagged and the cursor carries its rank
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.True(t, page.Items[0].SuperLike)
+		require.NotNil(t, page.NextCursor)
+		assert.True(t, page.NextCursor.SuperLike)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -104,19 +305,18 @@ func TestListLikersByRecipient(t *testing.T) {
 		limit := 10
 
 		// Setup expected query to return an error
-		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp FROM user_decisions WHERE recipient_id = ? AND liked = TRUE ORDER BY updated_at DESC, actor_id DESC LIMIT ?"
+		expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(recipientID, limit+1).
 			WillReturnError(errors.New("database error"))
 
 		// Call the method
-		likers, nextCursor, err := repo.ListLikersByRecipient(ctx, recipientID, nil, limit)
+		page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
 
 		// Assert results
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "database query failed")
-		assert.Nil(t, likers)
-		assert.Nil(t, nextCursor)
+		assert.Nil(t, page)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -142,9 +342,9 @@ func TestListNewLikersByRecipient(t *testing.T) {
 		repo := NewDecisionRepositoryImpl(db)
 
 		// Setup expected query and response
-		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
-			AddRow("actor1", int64(1738754100)).
-			AddRow("actor2", int64(1738686000))
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor1", int64(1738754100), false).
+			AddRow("actor2", int64(1738686000), false)
 
 		// Since this query is complex with whitespace variations, we have to use substring matching
 		mock.ExpectQuery("SELECT d1.actor_id, UNIX_TIMESTAMP").
@@ -152,16 +352,16 @@ func TestListNewLikersByRecipient(t *testing.T) {
 			WillReturnRows(rows)
 
 		// Call the method
-		likers, nextCursor, err := repo.ListNewLikersByRecipient(ctx, recipientID, nil, limit)
+		page, err := repo.ListNewLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.Len(t, likers, 2)
-		assert.Equal(t, "actor1", likers[0].ActorID)
-		assert.Equal(t, uint64(1738754100), likers[0].UnixTimestamp)
-		assert.Equal(t, "actor2", likers[1].ActorID)
-		assert.Equal(t, uint64(1738686000), likers[1].UnixTimestamp)
-		assert.Nil(t, nextCursor)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "actor1", page.Items[0].ActorID)
+		assert.Equal(t, uint64(1738754100), page.Items[0].UnixTimestamp)
+		assert.Equal(t, "actor2", page.Items[1].ActorID)
+		assert.Equal(t, uint64(1738686000), page.Items[1].UnixTimestamp)
+		assert.Nil(t, page.NextCursor)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -187,27 +387,27 @@ func TestListNewLikersByRecipient(t *testing.T) {
 		repo := NewDecisionRepositoryImpl(db)
 
 		// Setup expected query and response
-		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
-			AddRow("actor4", int64(1738400000)).
-			AddRow("actor5", int64(1738300000)).
-			AddRow("actor6", int64(1738200000)) // Extra row to test pagination
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+			AddRow("actor4", int64(1738400000), false).
+			AddRow("actor5", int64(1738300000), false).
+			AddRow("actor6", int64(1738200000), false) // Extra row to test pagination
 
 		// Since this query is complex with whitespace variations, we have to use substring matching
 		mock.ExpectQuery("SELECT d1.actor_id, UNIX_TIMESTAMP").
-			WithArgs(recipientID, cursorTime, cursorTime, "actor3", limit+1).
+			WithArgs(recipientID, false, false, cursorTime, cursorTime, "actor3", limit+1).
 			WillReturnRows(rows)
 
 		// Call the method
-		likers, nextCursor, err := repo.ListNewLikersByRecipient(ctx, recipientID, cursor, limit)
+		page, err := repo.ListNewLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, cursor, limit)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.Len(t, likers, 2)
-		assert.Equal(t, "actor4", likers[0].ActorID)
-		assert.Equal(t, "actor5", likers[1].ActorID)
-		assert.NotNil(t, nextCursor)
-		assert.Equal(t, time.Unix(1738300000, 0), nextCursor.UpdatedAt)
-		assert.Equal(t, "actor5", nextCursor.ActorId)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "actor4", page.Items[0].ActorID)
+		assert.Equal(t, "actor5", page.Items[1].ActorID)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, time.Unix(1738300000, 0), page.NextCursor.UpdatedAt)
+		assert.Equal(t, "actor5", page.NextCursor.ActorId)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -238,7 +438,7 @@ func TestCountLikersByRecipient(t *testing.T) {
 			AddRow(expectedCount)
 
 		// Define expected SQL with args
-		expectedSQL := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE"
+		expectedSQL := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) )"
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(recipientID).
 			WillReturnRows(rows)
@@ -261,7 +461,7 @@ func TestCountLikersByRecipient(t *testing.T) {
 		recipientID := "recipient1"
 
 		// Setup expected query to return an error
-		expectedSQL := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE"
+		expectedSQL := "SELECT COUNT(*) FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) )"
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(recipientID).
 			WillReturnError(errors.New("database error"))
@@ -281,6 +481,62 @@ func TestCountLikersByRecipient(t *testing.T) {
 	})
 }
 
+func TestGetLikeSummary(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	expectedSQL := "SELECT COUNT(*), COALESCE(SUM(d2.actor_id IS NULL), 0), COALESCE(SUM(d2.liked = TRUE), 0) FROM user_decisions d1 LEFT JOIN user_decisions d2 ON d1.actor_id = d2.recipient_id AND d2.actor_id = d1.recipient_id WHERE d1.recipient_id = ? AND d1.liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = d1.recipient_id AND b.blocked_id = d1.actor_id) OR (b.blocker_id = d1.actor_id AND b.blocked_id = d1.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = d1.recipient_id AND u.unmatched_id = d1.actor_id) OR (u.unmatcher_id = d1.actor_id AND u.unmatched_id = d1.recipient_id) )"
+
+	t.Run("Success", func(t *testing.T) {
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"total", "new", "matches"}).
+			AddRow(uint64(7), uint64(4), uint64(3))
+		mock.ExpectQuery(expectedSQL).
+			WithArgs("recipient1").
+			WillReturnRows(rows)
+
+		// Call the method
+		summary, err := repo.GetLikeSummary(ctx, "recipient1")
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Equal(t, entity.LikeSummary{Total: 7, New: 4, Matches: 3}, summary)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("DatabaseError", func(t *testing.T) {
+		// Setup expected query to return an error
+		mock.ExpectQuery(expectedSQL).
+			WithArgs("recipient1").
+			WillReturnError(errors.New("database error"))
+
+		// Call the method
+		summary, err := repo.GetLikeSummary(ctx, "recipient1")
+
+		// Assert results
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to summarise likers")
+		assert.Equal(t, entity.LikeSummary{}, summary)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
 func TestCreateOrUpdateDecision(t *testing.T) {
 	// Create a new mock database connection with QueryMatcherEqual
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -297,33 +553,136 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		// Define test data
 		actorID := "actor1"
 		recipientID := "recipient1"
-		liked := true
+		decisionType := entity.DecisionTypeLike
 
 		// Setup transaction expectations
 		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup block check expectation
+		blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		// Setup unmatch check expectation
+		unmatchSQL := "SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE"
+		mock.ExpectQuery(unmatchSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		// Setup insert/update expectation
-		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE liked = ?, updated_at = NOW()"
+		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()"
 		mock.ExpectExec(expectedSQL).
-			WithArgs(actorID, recipientID, liked, liked).
+			WithArgs(actorID, recipientID, "like", "like").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "like", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("like", actorID, "like", recipientID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Setup read back expectation, the actor had passed on the recipient before
+		decidedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow("pass", decidedAt, nil))
+
 		// Setup mutual like check expectation
-		checkSQL := "SELECT EXISTS( SELECT 1 FROM user_decisions WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE )"
-		checkRows := sqlmock.NewRows([]string{"exists"}).AddRow(1) // 1 means mutual like exists
-		mock.ExpectQuery(checkSQL).
+		checkRows := sqlmock.NewRows([]string{"matched_at"}).AddRow(nil) // A row means mutual like exists
+		mock.ExpectQuery(checkMutualSQL).
 			WithArgs(recipientID, actorID).
 			WillReturnRows(checkRows)
 
+		// Setup match time expectation, this like formed the match
+		mock.ExpectExec(matchTimeSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup match event expectation
+		mock.ExpectExec(sequenceSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("match", actorID, nil, recipientID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
 		// Setup commit expectation
 		mock.ExpectCommit()
 
 		// Call the method
-		mutualLike, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, liked)
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.True(t, mutualLike)
+		assert.True(t, outcome.Matched)
+		assert.True(t, outcome.NewMatch)
+		assert.Equal(t, decidedAt, outcome.MatchedAt)
+		require.NotNil(t, outcome.PreviousType)
+		assert.Equal(t, entity.DecisionTypePass, *outcome.PreviousType)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_RepeatedLikeKeepsMatchTime", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		recipientID := "recipient7"
+		decisionType := entity.DecisionTypeSuperLike
+
+		// Setup transaction expectations
+		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()").
+			WithArgs(actorID, recipientID, "super_like", "super_like").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "super_like", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("like", actorID, "super_like", recipientID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The actor upgrades the like that formed the match, the recipient's is left from an earlier match
+		matchedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow("like", matchedAt.Add(48*time.Hour), matchedAt))
+		mock.ExpectQuery(checkMutualSQL).
+			WithArgs(recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"matched_at"}).AddRow(matchedAt.Add(-30 * 24 * time.Hour)))
+
+		// No match event, the match already existed
+		mock.ExpectCommit()
+
+		// Call the method
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
+
+		// Assert the match time is when the match formed rather than now
+		require.NoError(t, err)
+		assert.True(t, outcome.Matched)
+		assert.False(t, outcome.NewMatch)
+		assert.Equal(t, matchedAt, outcome.MatchedAt)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -335,21 +694,49 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		// Define test data
 		actorID := "actor1"
 		recipientID := "recipient2"
-		liked := true
+		decisionType := entity.DecisionTypeLike
 
 		// Setup transaction expectations
 		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup block check expectation
+		blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		// Setup unmatch check expectation
+		unmatchSQL := "SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE"
+		mock.ExpectQuery(unmatchSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		// Setup insert/update expectation
-		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE liked = ?, updated_at = NOW()"
+		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()"
 		mock.ExpectExec(expectedSQL).
-			WithArgs(actorID, recipientID, liked, liked).
+			WithArgs(actorID, recipientID, "like", "like").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "like", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("like", actorID, "like", recipientID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
+		// Setup read back expectation, this is the actor's first decision about the recipient
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow(nil, time.Now(), nil))
+
 		// Setup mutual like check expectation
-		checkSQL := "SELECT EXISTS( SELECT 1 FROM user_decisions WHERE actor_id = ? AND recipient_id = ? AND liked = TRUE )"
-		checkRows := sqlmock.NewRows([]string{"exists"}).AddRow(0) // 0 means no mutual like
-		mock.ExpectQuery(checkSQL).
+		checkRows := sqlmock.NewRows([]string{"matched_at"}) // No rows means no mutual like
+		mock.ExpectQuery(checkMutualSQL).
 			WithArgs(recipientID, actorID).
 			WillReturnRows(checkRows)
 
@@ -357,11 +744,12 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		mock.ExpectCommit()
 
 		// Call the method
-		mutualLike, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, liked)
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.False(t, mutualLike)
+		assert.False(t, outcome.Matched)
+		assert.Nil(t, outcome.PreviousType)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -373,16 +761,39 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		// Define test data
 		actorID := "actor1"
 		recipientID := "recipient3"
-		liked := false // This is a pass, not a like
+		decisionType := entity.DecisionTypePass // This is a pass, not a like
 
 		// Setup transaction expectations
 		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup block check expectation
+		blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		// Setup insert/update expectation
-		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE liked = ?, updated_at = NOW()"
+		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()"
 		mock.ExpectExec(expectedSQL).
-			WithArgs(actorID, recipientID, liked, liked).
+			WithArgs(actorID, recipientID, "pass", "pass").
 			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "pass", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("pass", actorID, "pass", recipientID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Setup read back expectation, the actor had liked the recipient before
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow("like", time.Now(), nil))
 
 		// No mutual like check for pass decisions
 
@@ -390,11 +801,47 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		mock.ExpectCommit()
 
 		// Call the method
-		mutualLike, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, liked)
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
 
 		// Assert results
 		require.NoError(t, err)
-		assert.False(t, mutualLike) // A pass can't create a mutual like
+		assert.False(t, outcome.Matched) // A pass can't create a mutual like
+		require.NotNil(t, outcome.PreviousType)
+		assert.Equal(t, entity.DecisionTypeLike, *outcome.PreviousType)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		recipientID := "recipient6"
+		decisionType := entity.DecisionTypeLike
+
+		// Setup transaction expectations
+		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup block check to find a block
+		blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		// Setup rollback expectation
+		mock.ExpectRollback()
+
+		// Call the method
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
+
+		// Assert results
+		require.ErrorIs(t, err, ErrBlocked)
+		assert.False(t, outcome.Matched)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -406,18 +853,18 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		// Define test data
 		actorID := "actor1"
 		recipientID := "recipient4"
-		liked := true
+		decisionType := entity.DecisionTypeLike
 
 		// Setup transaction to fail
 		mock.ExpectBegin().WillReturnError(errors.New("transaction error"))
 
 		// Call the method
-		mutualLike, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, liked)
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
 
 		// Assert results
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to begin transaction")
-		assert.False(t, mutualLike)
+		assert.False(t, outcome.Matched)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -429,27 +876,42 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 		// Define test data
 		actorID := "actor1"
 		recipientID := "recipient5"
-		liked := true
+		decisionType := entity.DecisionTypeLike
 
 		// Setup transaction expectations
 		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs(recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Setup block check expectation
+		blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		// Setup unmatch check expectation
+		unmatchSQL := "SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE"
+		mock.ExpectQuery(unmatchSQL).
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		// Setup insert/update to fail
-		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE liked = ?, updated_at = NOW()"
+		expectedSQL := "INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()"
 		mock.ExpectExec(expectedSQL).
-			WithArgs(actorID, recipientID, liked, liked).
+			WithArgs(actorID, recipientID, "like", "like").
 			WillReturnError(errors.New("query error"))
 
 		// Setup rollback expectation
 		mock.ExpectRollback()
 
 		// Call the method
-		mutualLike, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, liked)
+		outcome, err := repo.CreateOrUpdateDecision(ctx, actorID, recipientID, decisionType)
 
 		// Assert results
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to put decision")
-		assert.False(t, mutualLike)
+		assert.False(t, outcome.Matched)
 
 		// Ensure all expectations were met
 		if err := mock.ExpectationsWereMet(); err != nil {
@@ -458,7 +920,7 @@ func TestCreateOrUpdateDecision(t *testing.T) {
 	})
 }
 
-func TestExecuteLikersQuery_ScanError(t *testing.T) {
+func TestCreateOrUpdateDecisions(t *testing.T) {
 	// Create a new mock database connection with QueryMatcherEqual
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
@@ -470,28 +932,847 @@ func TestExecuteLikersQuery_ScanError(t *testing.T) {
 	// Create a test context
 	ctx := context.Background()
 
-	// Define test data
-	recipientID := "recipient1"
-	limit := 10
-
-	// Setup expected query with type mismatch to force scan error
-	rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
-		AddRow("actor1", "not a number") // This will cause a scan error
-
-	// Define expected SQL with args
-	expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp FROM user_decisions WHERE recipient_id = ? AND liked = TRUE ORDER BY updated_at DESC, actor_id DESC LIMIT ?"
-	mock.ExpectQuery(expectedSQL).
-		WithArgs(recipientID, limit+1).
-		WillReturnRows(rows)
+	insertSQL := "INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) ON DUPLICATE KEY UPDATE previous_decision_type = decision_type, previous_updated_at = updated_at, decision_type = ?, updated_at = NOW()"
+	blockSQL := "SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE"
+	unmatchSQL := "SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE"
+	zeroCount := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"count"}).AddRow(0) }
 
-	// Call the method
-	likers, nextCursor, err := repo.ListLikersByRecipient(ctx, recipientID, nil, limit)
+	t.Run("Success_WithFailedDecision", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		decisions := []entity.PendingDecision{
+			{RecipientID: "recipient1", Type: entity.DecisionTypeLike},
+			{RecipientID: "recipient2", Type: entity.DecisionTypeLike},
+			{RecipientID: "recipient3", Type: entity.DecisionTypePass},
+		}
 
-	// Assert results
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO decision_outbox_sequences (recipient_id, last_sequence) VALUES (?, 0), (?, 0), (?, 0) ON DUPLICATE KEY UPDATE last_sequence = last_sequence").
+			WithArgs("recipient1", "recipient2", "recipient3").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		// The first decision completes a mutual like
+		mock.ExpectExec("SAVEPOINT decision").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, "recipient1", "recipient1", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectQuery(unmatchSQL).
+			WithArgs(actorID, "recipient1", "recipient1", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectExec(insertSQL).
+			WithArgs(actorID, "recipient1", "like", "like").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, "recipient1", "like", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs("recipient1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("like", actorID, "like", "recipient1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, "recipient1").
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow(nil, time.Now(), nil))
+		mock.ExpectQuery(checkMutualSQL).
+			WithArgs("recipient1", actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"matched_at"}).AddRow(nil))
+		mock.ExpectExec(matchTimeSQL).
+			WithArgs(actorID, "recipient1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs("recipient1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("match", actorID, nil, "recipient1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The second decision fails and is rolled back on its own
+		mock.ExpectExec("SAVEPOINT decision").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, "recipient2", "recipient2", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectQuery(unmatchSQL).
+			WithArgs(actorID, "recipient2", "recipient2", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectExec(insertSQL).
+			WithArgs(actorID, "recipient2", "like", "like").
+			WillReturnError(errors.New("data too long"))
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT decision").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// The third decision is a pass
+		mock.ExpectExec("SAVEPOINT decision").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, "recipient3", "recipient3", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectExec(insertSQL).
+			WithArgs(actorID, "recipient3", "pass", "pass").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, "recipient3", "pass", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs("recipient3").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("pass", actorID, "pass", "recipient3").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, "recipient3").
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow(nil, time.Now(), nil))
+
+		mock.ExpectCommit()
+
+		// Call the method
+		results, err := repo.CreateOrUpdateDecisions(ctx, actorID, decisions)
+
+		// Assert results
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, "recipient1", results[0].RecipientID)
+		assert.True(t, results[0].Outcome.Matched)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "recipient2", results[1].RecipientID)
+		require.Error(t, results[1].Err)
+		assert.Contains(t, results[1].Err.Error(), "failed to put decision")
+		assert.Equal(t, "recipient3", results[2].RecipientID)
+		assert.False(t, results[2].Outcome.Matched)
+		assert.NoError(t, results[2].Err)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("CommitError", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		decisions := []entity.PendingDecision{{RecipientID: "recipient1", Type: entity.DecisionTypePass}}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(lockRecipientSQL).
+			WithArgs("recipient1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SAVEPOINT decision").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(blockSQL).
+			WithArgs(actorID, "recipient1", "recipient1", actorID).
+			WillReturnRows(zeroCount())
+		mock.ExpectExec(insertSQL).
+			WithArgs(actorID, "recipient1", "pass", "pass").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, "recipient1", "pass", "put").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(sequenceSQL).
+			WithArgs("recipient1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(outboxSQL).
+			WithArgs("pass", actorID, "pass", "recipient1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(readDecisionSQL).
+			WithArgs(actorID, "recipient1").
+			WillReturnRows(sqlmock.NewRows(readDecisionColumns).AddRow(nil, time.Now(), nil))
+		mock.ExpectCommit().WillReturnError(errors.New("commit error"))
+
+		// Call the method
+		results, err := repo.CreateOrUpdateDecisions(ctx, actorID, decisions)
+
+		// Assert every decision in the transaction is reported as failed
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Error(t, results[0].Err)
+		assert.Contains(t, results[0].Err.Error(), "failed to commit transaction")
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Canceled_FailsRemainingDecisions", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		decisions := []entity.PendingDecision{
+			{RecipientID: "recipient1", Type: entity.DecisionTypeLike},
+			{RecipientID: "recipient2", Type: entity.DecisionTypePass},
+		}
+
+		// No transaction is started once the context has ended
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		// Call the method
+		results, err := repo.CreateOrUpdateDecisions(canceled, actorID, decisions)
+
+		// Assert each decision is reported as failed rather than the whole call
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for i, result := range results {
+			assert.Equal(t, decisions[i].RecipientID, result.RecipientID)
+			assert.ErrorIs(t, result.Err, ErrCanceled)
+		}
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestUndoDecision(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	actorID := "actor1"
+	recipientID := "recipient1"
+	window := 5 * time.Minute
+
+	selectSQL := "SELECT d.liked, d.previous_decision_type, d.previous_updated_at IS NULL AND d.created_at = d.updated_at, d.updated_at >= NOW() - INTERVAL ? SECOND, EXISTS( SELECT 1 FROM user_decisions r WHERE r.actor_id = d.recipient_id AND r.recipient_id = d.actor_id AND r.liked = TRUE ) FROM user_decisions d WHERE d.actor_id = ? AND d.recipient_id = ? FOR UPDATE"
+	columns := []string{"liked", "previous_decision_type", "first_decision", "within_window", "liked_back"}
+	restoreSQL := "UPDATE user_decisions SET decision_type = previous_decision_type, updated_at = previous_updated_at, previous_decision_type = NULL WHERE actor_id = ? AND recipient_id = ?"
+
+	t.Run("Success_RestoresPreviousAndDissolvesMatch", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(true, "pass", false, true, true))
+		mock.ExpectExec(restoreSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "pass", "undo").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Call the method
+		result, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert the earlier pass is back in place and the match is gone
+		require.NoError(t, err)
+		require.NotNil(t, result.Restored)
+		assert.Equal(t, entity.DecisionTypePass, *result.Restored)
+		assert.True(t, result.MatchDissolved)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_DeletesFirstDecision", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(false, nil, true, true, true))
+		mock.ExpectExec("DELETE FROM user_decisions WHERE actor_id = ? AND recipient_id = ?").
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, nil, "undo").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Call the method
+		result, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert the decision is gone, a pass never formed a match
+		require.NoError(t, err)
+		assert.Nil(t, result.Restored)
+		assert.False(t, result.MatchDissolved)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("DoubleUndo_KeepsRestoredFirstDecision", func(t *testing.T) {
+		// The first undo restores the pass the like replaced, which was the actor's first decision
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(true, "pass", false, true, false))
+		mock.ExpectExec(restoreSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "pass", "undo").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// The restore leaves previous_updated_at set, so the pass no longer reads as a first decision
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(false, nil, false, true, false))
+		mock.ExpectRollback()
+
+		// Call the method twice
+		_, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+		require.NoError(t, err)
+		_, err = repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert the second undo leaves the restored pass in place
+		assert.ErrorIs(t, err, ErrNothingToUndo)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_RestoredLikeRecordsMatchTime", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(false, "like", false, true, true))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(restoreSQL).
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE user_decisions SET matched_at = NOW(), updated_at = updated_at WHERE actor_id = ? AND recipient_id = ?").
+			WithArgs(actorID, recipientID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs(actorID, recipientID, "like", "undo").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Call the method
+		result, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert the like is back, which matches the recipient's again
+		require.NoError(t, err)
+		require.NotNil(t, result.Restored)
+		assert.Equal(t, entity.DecisionTypeLike, *result.Restored)
+		assert.False(t, result.MatchDissolved)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Unmatched_KeepsDecision", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(false, "like", false, true, true))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		// Call the method
+		_, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert the like the pass replaced isn't brought back
+		assert.ErrorIs(t, err, ErrUnmatched)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Blocked_KeepsDecision", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(false, "super_like", false, true, false))
+		mock.ExpectQuery("SELECT COUNT(*) FROM user_blocks WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?) FOR SHARE").
+			WithArgs(actorID, recipientID, recipientID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		// Call the method
+		_, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert results
+		assert.ErrorIs(t, err, ErrBlocked)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("WindowExpired", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(true, nil, true, false, false))
+		mock.ExpectRollback()
+
+		// Call the method
+		_, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert results
+		assert.ErrorIs(t, err, ErrUndoWindowExpired)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs(int64(300), actorID, recipientID).
+			WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectRollback()
+
+		// Call the method
+		_, err := repo.UndoDecision(ctx, actorID, recipientID, window)
+
+		// Assert results
+		assert.ErrorIs(t, err, ErrDecisionNotFound)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestGetRelationships(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		// Define test data
+		decidedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+		// user1 and user2 like each other, user3 passed on user1 and nothing is known about user4
+		rows := sqlmock.NewRows([]string{"actor_id", "recipient_id", "decision_type", "created_at", "updated_at"}).
+			AddRow("user1", "user2", "like", decidedAt, decidedAt).
+			AddRow("user2", "user1", "like", decidedAt, decidedAt).
+			AddRow("user3", "user1", "pass", decidedAt, decidedAt)
+
+		expectedSQL := "SELECT actor_id, recipient_id, decision_type, created_at, updated_at FROM user_decisions WHERE (actor_id = ? AND recipient_id IN (?, ?, ?)) OR (recipient_id = ? AND actor_id IN (?, ?, ?))"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs("user1", "user2", "user3", "user4", "user1", "user2", "user3", "user4").
+			WillReturnRows(rows)
+
+		// Call the method
+		relationships, err := repo.GetRelationships(ctx, "user1", []string{"user2", "user3", "user4"})
+
+		// Assert results
+		require.NoError(t, err)
+		require.Len(t, relationships, 3)
+
+		assert.Equal(t, "user2", relationships[0].UserBID)
+		assert.True(t, relationships[0].Matched())
+
+		assert.Equal(t, "user3", relationships[1].UserBID)
+		assert.Nil(t, relationships[1].AToB)
+		require.NotNil(t, relationships[1].BToA)
+		assert.False(t, relationships[1].BToA.Liked())
+		assert.False(t, relationships[1].Matched())
+
+		assert.Equal(t, "user4", relationships[2].UserBID)
+		assert.Nil(t, relationships[2].AToB)
+		assert.Nil(t, relationships[2].BToA)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestExecuteLikersQuery_ScanError(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	// Define test data
+	recipientID := "recipient1"
+	limit := 10
+
+	// Setup expected query with type mismatch to force scan error
+	rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp", "super_liked"}).
+		AddRow("actor1", "not a number", false) // This will cause a scan error
+
+	// Define expected SQL with args
+	expectedSQL := "SELECT actor_id, UNIX_TIMESTAMP(updated_at) as unix_timestamp, super_liked FROM user_decisions WHERE recipient_id = ? AND liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) OR (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) ) AND NOT EXISTS ( SELECT 1 FROM user_unmatches u WHERE (u.unmatcher_id = user_decisions.recipient_id AND u.unmatched_id = user_decisions.actor_id) OR (u.unmatcher_id = user_decisions.actor_id AND u.unmatched_id = user_decisions.recipient_id) ) ORDER BY super_liked DESC, updated_at DESC, actor_id DESC LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(recipientID, limit+1).
+		WillReturnRows(rows)
+
+	// Call the method
+	page, err := repo.ListLikersByRecipient(ctx, recipientID, entity.LikerFilter{}, nil, limit)
+
+	// Assert results
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to scan row")
-	assert.Nil(t, likers)
-	assert.Nil(t, nextCursor)
+	assert.Nil(t, page)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListMatchesByUser(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	t.Run("Success_WithCursor", func(t *testing.T) {
+		// Define test data
+		userID := "user1"
+		limit := 2
+		cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		cursor := &entity.Cursor{
+			UpdatedAt: cursorTime,
+			ActorId:   "user3",
+		}
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "unix_timestamp"}).
+			AddRow("user4", int64(1738400000)).
+			AddRow("user5", int64(1738300000)).
+			AddRow("user6", int64(1738200000)) // Extra row to test pagination
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT d1.actor_id, UNIX_TIMESTAMP(GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at))) as unix_timestamp FROM user_decisions d1 JOIN user_decisions d2 ON d2.actor_id = d1.recipient_id AND d2.recipient_id = d1.actor_id AND d2.liked = TRUE WHERE d1.recipient_id = ? AND d1.liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = d1.recipient_id AND b.blocked_id = d1.actor_id) OR (b.blocker_id = d1.actor_id AND b.blocked_id = d1.recipient_id) ) AND (GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at)) < ? OR (GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at)) = ? AND d1.actor_id < ?)) ORDER BY GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at)) DESC, d1.actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(userID, cursorTime, cursorTime, "user3", limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListMatchesByUser(ctx, userID, cursor, limit)
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "user4", page.Items[0].UserID)
+		assert.Equal(t, uint64(1738400000), page.Items[0].UnixTimestamp)
+		assert.Equal(t, "user5", page.Items[1].UserID)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, time.Unix(1738300000, 0), page.NextCursor.UpdatedAt)
+		assert.Equal(t, "user5", page.NextCursor.ActorId)
+		require.NotNil(t, page.PreviousCursor)
+		assert.Equal(t, "user4", page.PreviousCursor.ActorId)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("DatabaseError", func(t *testing.T) {
+		// Define test data
+		userID := "user1"
+		limit := 10
+
+		// Setup expected query to return an error
+		expectedSQL := "SELECT d1.actor_id, UNIX_TIMESTAMP(GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at))) as unix_timestamp FROM user_decisions d1 JOIN user_decisions d2 ON d2.actor_id = d1.recipient_id AND d2.recipient_id = d1.actor_id AND d2.liked = TRUE WHERE d1.recipient_id = ? AND d1.liked = TRUE AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = d1.recipient_id AND b.blocked_id = d1.actor_id) OR (b.blocker_id = d1.actor_id AND b.blocked_id = d1.recipient_id) ) ORDER BY GREATEST(COALESCE(d1.matched_at, d2.matched_at), COALESCE(d2.matched_at, d1.matched_at)) DESC, d1.actor_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(userID, limit+1).
+			WillReturnError(errors.New("database error"))
+
+		// Call the method
+		page, err := repo.ListMatchesByUser(ctx, userID, nil, limit)
+
+		// Assert results
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "database query failed")
+		assert.Nil(t, page)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestListDecisionsByActor(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	t.Run("Success_LikedWithCursor", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		limit := 2
+		cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		cursor := &entity.Cursor{
+			UpdatedAt: cursorTime,
+			ActorId:   "recipient3",
+		}
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "recipient_id", "decision_type", "created_at", "updated_at"}).
+			AddRow(actorID, "recipient4", "like", cursorTime, cursorTime.Add(-time.Hour)).
+			AddRow(actorID, "recipient5", "like", cursorTime, cursorTime.Add(-2*time.Hour)).
+			AddRow(actorID, "recipient6", "like", cursorTime, cursorTime.Add(-3*time.Hour)) // Extra row to test pagination
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT actor_id, recipient_id, decision_type, created_at, updated_at FROM user_decisions WHERE actor_id = ? AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) OR (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) ) AND liked = TRUE AND (updated_at < ? OR (updated_at = ? AND recipient_id < ?)) ORDER BY updated_at DESC, recipient_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(actorID, cursorTime, cursorTime, "recipient3", limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListDecisionsByActor(ctx, actorID, entity.DecisionFilterLiked, cursor, limit)
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "recipient4", page.Items[0].RecipientID)
+		assert.True(t, page.Items[0].Liked())
+		assert.Equal(t, "recipient5", page.Items[1].RecipientID)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, cursorTime.Add(-2*time.Hour), page.NextCursor.UpdatedAt)
+		assert.Equal(t, "recipient5", page.NextCursor.ActorId)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Success_AllWithoutCursor", func(t *testing.T) {
+		// Define test data
+		actorID := "actor1"
+		limit := 10
+
+		// Setup expected query and response
+		rows := sqlmock.NewRows([]string{"actor_id", "recipient_id", "decision_type", "created_at", "updated_at"})
+
+		// Define expected SQL with args
+		expectedSQL := "SELECT actor_id, recipient_id, decision_type, created_at, updated_at FROM user_decisions WHERE actor_id = ? AND NOT EXISTS ( SELECT 1 FROM user_blocks b WHERE (b.blocker_id = user_decisions.actor_id AND b.blocked_id = user_decisions.recipient_id) OR (b.blocker_id = user_decisions.recipient_id AND b.blocked_id = user_decisions.actor_id) ) ORDER BY updated_at DESC, recipient_id DESC LIMIT ?"
+		mock.ExpectQuery(expectedSQL).
+			WithArgs(actorID, limit+1).
+			WillReturnRows(rows)
+
+		// Call the method
+		page, err := repo.ListDecisionsByActor(ctx, actorID, entity.DecisionFilterAll, nil, limit)
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Empty(t, page.Items)
+		assert.Nil(t, page.NextCursor)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestUnmatch(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	selectSQL := "SELECT COUNT(*) FROM user_decisions WHERE ((actor_id = ? AND recipient_id = ?) OR (actor_id = ? AND recipient_id = ?)) AND liked = TRUE FOR UPDATE"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs("user1", "user2", "user2", "user1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectExec("INSERT INTO user_unmatches (unmatcher_id, unmatched_id, created_at) VALUES (?, ?, NOW())").
+			WithArgs("user1", "user2").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE user_decisions SET previous_decision_type = NULL, previous_updated_at = updated_at, decision_type = 'pass', updated_at = NOW() WHERE actor_id = ? AND recipient_id = ?").
+			WithArgs("user1", "user2").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(historySQL).
+			WithArgs("user1", "user2", "pass", "unmatch").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Call the method
+		err := repo.Unmatch(ctx, "user1", "user2")
+
+		// Assert results
+		require.NoError(t, err)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("NotMatched", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectSQL).
+			WithArgs("user1", "user3", "user3", "user1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		// Call the method
+		err := repo.Unmatch(ctx, "user1", "user3")
+
+		// Assert results
+		require.ErrorIs(t, err, ErrNotMatched)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestClearUnmatch(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	mock.ExpectExec("DELETE FROM user_unmatches WHERE (unmatcher_id = ? AND unmatched_id = ?) OR (unmatcher_id = ? AND unmatched_id = ?)").
+		WithArgs("user1", "user2", "user2", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the method
+	cleared, err := repo.ClearUnmatch(context.Background(), "user1", "user2")
+
+	// Assert results
+	require.NoError(t, err)
+	assert.True(t, cleared)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListDecisionHistory(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Define test data
+	limit := 2
+	cursorTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.Cursor{
+		UpdatedAt: cursorTime,
+		ActorId:   "42",
+	}
+
+	// Setup expected query and response
+	rows := sqlmock.NewRows([]string{"id", "actor_id", "recipient_id", "decision_type", "change", "created_at"}).
+		AddRow(41, "user1", "user2", nil, "undo", cursorTime.Add(-time.Minute)).
+		AddRow(40, "user1", "user2", "super_like", "put", cursorTime.Add(-2*time.Minute)).
+		AddRow(39, "user1", "user2", "pass", "put", cursorTime.Add(-time.Hour)) // Extra row to test pagination
+
+	expectedSQL := "SELECT id, actor_id, recipient_id, decision_type, `change`, created_at FROM user_decision_history WHERE actor_id = ? AND recipient_id = ? AND (created_at < ? OR (created_at = ? AND id < ?)) ORDER BY created_at DESC, id DESC LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("user1", "user2", cursorTime, cursorTime, "42", limit+1).
+		WillReturnRows(rows)
+
+	// Call the method
+	page, err := repo.ListDecisionHistory(context.Background(), "user1", "user2", cursor, limit)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Nil(t, page.Items[0].Type)
+	assert.Equal(t, entity.DecisionChangeUndo, page.Items[0].Change)
+	require.NotNil(t, page.Items[1].Type)
+	assert.Equal(t, entity.DecisionTypeSuperLike, *page.Items[1].Type)
+	assert.Equal(t, entity.DecisionChangePut, page.Items[1].Change)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, "40", page.NextCursor.ActorId)
+	require.NotNil(t, page.PreviousCursor)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListLikeTimesByActor(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewDecisionRepositoryImpl(db)
+
+	// Setup expected query and response
+	since := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"created_at"}).
+		AddRow(since.Add(2 * time.Hour)).
+		AddRow(since.Add(time.Hour))
+
+	expectedSQL := "SELECT created_at FROM user_decision_history WHERE actor_id = ? AND `change` = 'put' AND decision_type IN ('like', 'super_like') AND created_at > ? ORDER BY created_at DESC LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("user1", since, 100).
+		WillReturnRows(rows)
+
+	// Call the method
+	likedAt, err := repo.ListLikeTimesByActor(context.Background(), "user1", since, 100)
+
+	// Assert results
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{since.Add(2 * time.Hour), since.Add(time.Hour)}, likedAt)
 
 	// Ensure all expectations were met
 	if err := mock.ExpectationsWereMet(); err != nil {
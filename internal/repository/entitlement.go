@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+)
+
+type EntitlementRepository interface {
+	// GetTier returns the tier the user has been put on, empty if they haven't been put on one
+	GetTier(ctx context.Context, userID string) (string, error)
+
+	// SetTier puts the user on the tier, or takes them off the one they're on if it's empty
+	SetTier(ctx context.Context, userID string, tier string) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEntitlementRepository is a mock implementation of EntitlementRepository
+type MockEntitlementRepository struct {
+	mock.Mock
+}
+
+// Ensure MockEntitlementRepository implements EntitlementRepository interface
+var _ EntitlementRepository = (*MockEntitlementRepository)(nil)
+
+func (m *MockEntitlementRepository) GetTier(ctx context.Context, userID string) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockEntitlementRepository) SetTier(ctx context.Context, userID string, tier string) error {
+	args := m.Called(ctx, userID, tier)
+	return args.Error(0)
+}
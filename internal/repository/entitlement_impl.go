@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+type EntitlementRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewEntitlementRepositoryImpl(db *sql.DB) EntitlementRepository {
+	return EntitlementRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r EntitlementRepositoryImpl) GetTier(ctx context.Context, userID string) (string, error) {
+	query := "SELECT tier FROM user_entitlements WHERE user_id = ?"
+
+	var tier string
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&tier)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", dbErrorf("failed to get tier: %w", err)
+	}
+
+	return tier, nil
+}
+
+func (r EntitlementRepositoryImpl) SetTier(ctx context.Context, userID string, tier string) error {
+	if tier == "" {
+		if _, err := r.db.ExecContext(ctx, "DELETE FROM user_entitlements WHERE user_id = ?", userID); err != nil {
+			return dbErrorf("failed to delete tier: %w", err)
+		}
+		return nil
+	}
+
+	query := `
+		INSERT INTO user_entitlements (user_id, tier)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE tier = ?`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, tier, tier); err != nil {
+		return dbErrorf("failed to put tier: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTier(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewEntitlementRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	expectedSQL := "SELECT tier FROM user_entitlements WHERE user_id = ?"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(expectedSQL).
+			WithArgs("user1").
+			WillReturnRows(sqlmock.NewRows([]string{"tier"}).AddRow("premium"))
+
+		// Call the method
+		tier, err := repo.GetTier(ctx, "user1")
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Equal(t, "premium", tier)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("NotSet", func(t *testing.T) {
+		mock.ExpectQuery(expectedSQL).
+			WithArgs("user2").
+			WillReturnRows(sqlmock.NewRows([]string{"tier"}))
+
+		// Call the method
+		tier, err := repo.GetTier(ctx, "user2")
+
+		// Assert results
+		require.NoError(t, err)
+		assert.Empty(t, tier)
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestSetTier(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewEntitlementRepositoryImpl(db)
+
+	// Create a test context
+	ctx := context.Background()
+
+	t.Run("Put", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO user_entitlements (user_id, tier) VALUES (?, ?) ON DUPLICATE KEY UPDATE tier = ?").
+			WithArgs("user1", "premium", "premium").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Call the method
+		require.NoError(t, repo.SetTier(ctx, "user1", "premium"))
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM user_entitlements WHERE user_id = ?").
+			WithArgs("user1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Call the method
+		require.NoError(t, repo.SetTier(ctx, "user1", ""))
+
+		// Ensure all expectations were met
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// The kinds of failure callers can act on, check for them with errors.Is
+var (
+	// ErrNotFound is returned when a row the caller expected doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when a write clashed with another, such as a deadlock or duplicate key, and can be retried
+	ErrConflict = errors.New("conflicting write")
+	// ErrUnavailable is returned when the database can't be reached or isn't accepting writes
+	ErrUnavailable = errors.New("database unavailable")
+	// ErrDeadlineExceeded is returned when a query or lock wait ran out of time
+	ErrDeadlineExceeded = errors.New("database deadline exceeded")
+	// ErrCanceled is returned when the caller cancelled the request
+	ErrCanceled = errors.New("request canceled")
+)
+
+// MySQL error numbers, from https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+const (
+	mysqlTooManyConnections = 1040
+	mysqlServerShutdown     = 1053
+	mysqlDuplicateEntry     = 1062
+	mysqlLockWaitTimeout    = 1205
+	mysqlDeadlock           = 1213
+	mysqlReadOnly           = 1290
+	mysqlReadOnlyMode       = 1836
+	mysqlQueryTimeout       = 3024
+)
+
+// Error is a failure of a known kind. It matches both its kind and the error it wraps,
+// so the underlying driver error is still there to be logged.
+type Error struct {
+	Kind error
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// dbErrorf wraps an error like fmt.Errorf, marking it with its kind if it's one callers can act on
+func dbErrorf(format string, args ...interface{}) error {
+	return classifyError(fmt.Errorf(format, args...))
+}
+
+// classifyError marks the error with its kind, returning it unchanged if it has no known kind or already has one
+func classifyError(err error) error {
+	var classified *Error
+	if err == nil || errors.As(err, &classified) {
+		return err
+	}
+
+	if kind := errorKind(err); kind != nil {
+		return &Error{Kind: kind, Err: err}
+	}
+	return err
+}
+
+// errorKind returns the kind of failure of a driver or context error, nil if it isn't known
+func errorKind(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrDeadlineExceeded
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrNotFound
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, mysql.ErrInvalidConn), errors.Is(err, sql.ErrConnDone):
+		return ErrUnavailable
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case mysqlDuplicateEntry, mysqlDeadlock:
+			return ErrConflict
+		case mysqlLockWaitTimeout, mysqlQueryTimeout:
+			return ErrDeadlineExceeded
+		case mysqlTooManyConnections, mysqlServerShutdown, mysqlReadOnly, mysqlReadOnlyMode:
+			return ErrUnavailable
+		}
+		return nil
+	}
+
+	// Failing to dial or losing the connection mid-query
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ErrDeadlineExceeded
+		}
+		return ErrUnavailable
+	}
+
+	return nil
+}
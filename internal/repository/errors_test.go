@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"Deadlock", &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}, ErrConflict},
+		{"DuplicateEntry", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, ErrConflict},
+		{"LockWaitTimeout", &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded"}, ErrDeadlineExceeded},
+		{"TooManyConnections", &mysql.MySQLError{Number: 1040, Message: "Too many connections"}, ErrUnavailable},
+		{"Canceled", context.Canceled, ErrCanceled},
+		{"DeadlineExceeded", context.DeadlineExceeded, ErrDeadlineExceeded},
+		{"NoRows", sql.ErrNoRows, ErrNotFound},
+		{"InvalidConn", mysql.ErrInvalidConn, ErrUnavailable},
+		{"ConnectionRefused", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, ErrUnavailable},
+		{"Unknown", &mysql.MySQLError{Number: 1064, Message: "You have an error in your SQL syntax"}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := dbErrorf("failed to put decision: %w", test.err)
+
+			// The original error is kept for logging either way
+			assert.ErrorIs(t, err, test.err)
+			assert.Contains(t, err.Error(), "failed to put decision: ")
+			for _, kind := range []error{ErrNotFound, ErrConflict, ErrUnavailable, ErrDeadlineExceeded, ErrCanceled} {
+				assert.Equal(t, kind == test.kind, errors.Is(err, kind), "kind %v", kind)
+			}
+		})
+	}
+
+	t.Run("AlreadyClassified", func(t *testing.T) {
+		err := dbErrorf("failed to undo decision: %w", ErrDecisionNotFound)
+
+		assert.ErrorIs(t, err, ErrDecisionNotFound)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, fmt.Sprintf("failed to undo decision: %v", ErrDecisionNotFound), err.Error())
+	})
+}
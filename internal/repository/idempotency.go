@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type IdempotencyRepository interface {
+	// ClaimKey claims the user's idempotency key for the request until the ttl has passed, taking over an expired key.
+	// It returns nil once the key is claimed, or what's stored for the key if another request has it.
+	ClaimKey(ctx context.Context, userID string, key string, requestHash []byte, ttl time.Duration) (*entity.StoredResponse, error)
+
+	// PutResponse stores the response for the user's claimed idempotency key until the ttl has passed
+	PutResponse(ctx context.Context, userID string, key string, response entity.StoredResponse, ttl time.Duration) error
+
+	// ReleaseKey gives up the claim on the user's idempotency key, so the request can be retried with it
+	ReleaseKey(ctx context.Context, userID string, key string) error
+
+	// DeleteExpired deletes up to limit expired keys, returning how many were deleted
+	DeleteExpired(ctx context.Context, limit int) (int64, error)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockIdempotencyRepository is a mock implementation of IdempotencyRepository
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+// Ensure MockIdempotencyRepository implements IdempotencyRepository interface
+var _ IdempotencyRepository = (*MockIdempotencyRepository)(nil)
+
+func (m *MockIdempotencyRepository) ClaimKey(ctx context.Context, userID string, key string, requestHash []byte, ttl time.Duration) (*entity.StoredResponse, error) {
+	args := m.Called(ctx, userID, key, requestHash, ttl)
+
+	var response *entity.StoredResponse
+	if args.Get(0) != nil {
+		response = args.Get(0).(*entity.StoredResponse)
+	}
+
+	return response, args.Error(1)
+}
+
+func (m *MockIdempotencyRepository) PutResponse(ctx context.Context, userID string, key string, response entity.StoredResponse, ttl time.Duration) error {
+	args := m.Called(ctx, userID, key, response, ttl)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) ReleaseKey(ctx context.Context, userID string, key string) error {
+	args := m.Called(ctx, userID, key)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) DeleteExpired(ctx context.Context, limit int) (int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type IdempotencyRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepositoryImpl(db *sql.DB) IdempotencyRepository {
+	return IdempotencyRepositoryImpl{
+		db: db,
+	}
+}
+
+// ClaimKey inserts a pending row for the key. The primary key lets only one request insert it,
+// so a retry arriving while the first request is still running finds the pending row rather than running again.
+func (r IdempotencyRepositoryImpl) ClaimKey(ctx context.Context, userID string, key string, requestHash []byte, ttl time.Duration) (*entity.StoredResponse, error) {
+	// An expired key is taken over by the new request
+	deleteQuery := "DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND expires_at <= NOW()"
+	if _, err := r.db.ExecContext(ctx, deleteQuery, userID, key); err != nil {
+		return nil, dbErrorf("failed to expire idempotency key: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, response, expires_at)
+		VALUES (?, ?, ?, NULL, NOW() + INTERVAL ? SECOND)`
+
+	_, err := r.db.ExecContext(ctx, insertQuery, userID, key, requestHash, int64(ttl.Seconds()))
+	if err == nil {
+		return nil, nil
+	}
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlDuplicateEntry {
+		return nil, dbErrorf("failed to claim idempotency key: %w", err)
+	}
+
+	// Another request has the key
+	selectQuery := `
+		SELECT request_hash, response, response IS NULL
+		FROM idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ?`
+
+	var stored entity.StoredResponse
+	err = r.db.QueryRowContext(ctx, selectQuery, userID, key).Scan(&stored.RequestHash, &stored.Response, &stored.Pending)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The other request gave up its claim in the meantime
+		return nil, &Error{Kind: ErrConflict, Err: errors.New("idempotency key was released while claiming it")}
+	}
+	if err != nil {
+		return nil, dbErrorf("failed to get idempotency key: %w", err)
+	}
+
+	return &stored, nil
+}
+
+func (r IdempotencyRepositoryImpl) PutResponse(ctx context.Context, userID string, key string, response entity.StoredResponse, ttl time.Duration) error {
+	query := `
+		UPDATE idempotency_keys
+		SET response = ?, expires_at = NOW() + INTERVAL ? SECOND
+		WHERE user_id = ? AND idempotency_key = ? AND request_hash = ?`
+
+	// An empty response must still be stored as a value, as NULL marks a pending key
+	encoded := response.Response
+	if encoded == nil {
+		encoded = []byte{}
+	}
+
+	_, err := r.db.ExecContext(ctx, query, encoded, int64(ttl.Seconds()), userID, key, response.RequestHash)
+	if err != nil {
+		return dbErrorf("failed to put idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r IdempotencyRepositoryImpl) ReleaseKey(ctx context.Context, userID string, key string) error {
+	// Only a pending key is released, a stored response stays for replays
+	query := "DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND response IS NULL"
+
+	if _, err := r.db.ExecContext(ctx, query, userID, key); err != nil {
+		return dbErrorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r IdempotencyRepositoryImpl) DeleteExpired(ctx context.Context, limit int) (int64, error) {
+	query := "DELETE FROM idempotency_keys WHERE expires_at <= NOW() LIMIT ?"
+
+	result, err := r.db.ExecContext(ctx, query, limit)
+	if err != nil {
+		return 0, dbErrorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, dbErrorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return deleted, nil
+}
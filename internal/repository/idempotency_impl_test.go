@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimKey(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewIdempotencyRepositoryImpl(db)
+
+	expireSQL := "DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND expires_at <= NOW()"
+	insertSQL := "INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, response, expires_at) VALUES (?, ?, ?, NULL, NOW() + INTERVAL ? SECOND)"
+	selectSQL := "SELECT request_hash, response, response IS NULL FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ?"
+	duplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+
+	t.Run("Claimed", func(t *testing.T) {
+		mock.ExpectExec(expireSQL).
+			WithArgs("user1", "key1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "key1", []byte("hash"), int64(60)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		stored, err := repo.ClaimKey(context.Background(), "user1", "key1", []byte("hash"), time.Minute)
+
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+	})
+
+	t.Run("Stored", func(t *testing.T) {
+		mock.ExpectExec(expireSQL).
+			WithArgs("user1", "key1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "key1", []byte("hash"), int64(60)).
+			WillReturnError(duplicate)
+		mock.ExpectQuery(selectSQL).
+			WithArgs("user1", "key1").
+			WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response", "pending"}).AddRow([]byte("hash"), []byte("response"), false))
+
+		stored, err := repo.ClaimKey(context.Background(), "user1", "key1", []byte("hash"), time.Minute)
+
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, []byte("hash"), stored.RequestHash)
+		assert.Equal(t, []byte("response"), stored.Response)
+		assert.False(t, stored.Pending)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		mock.ExpectExec(expireSQL).
+			WithArgs("user1", "key1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "key1", []byte("hash"), int64(60)).
+			WillReturnError(duplicate)
+		mock.ExpectQuery(selectSQL).
+			WithArgs("user1", "key1").
+			WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response", "pending"}).AddRow([]byte("hash"), nil, true))
+
+		stored, err := repo.ClaimKey(context.Background(), "user1", "key1", []byte("hash"), time.Minute)
+
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.True(t, stored.Pending)
+	})
+
+	t.Run("QueryError", func(t *testing.T) {
+		mock.ExpectExec(expireSQL).
+			WithArgs("user1", "key1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(insertSQL).
+			WithArgs("user1", "key1", []byte("hash"), int64(60)).
+			WillReturnError(errors.New("query error"))
+
+		_, err := repo.ClaimKey(context.Background(), "user1", "key1", []byte("hash"), time.Minute)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to claim idempotency key")
+	})
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPutResponse(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewIdempotencyRepositoryImpl(db)
+
+	expectedSQL := "UPDATE idempotency_keys SET response = ?, expires_at = NOW() + INTERVAL ? SECOND WHERE user_id = ? AND idempotency_key = ? AND request_hash = ?"
+
+	t.Run("EmptyResponse", func(t *testing.T) {
+		// An empty response isn't stored as NULL, which would leave the key pending
+		mock.ExpectExec(expectedSQL).
+			WithArgs([]byte{}, int64(86400), "user1", "key1", []byte("hash")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.PutResponse(context.Background(), "user1", "key1", entity.StoredResponse{RequestHash: []byte("hash")}, 24*time.Hour)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("QueryError", func(t *testing.T) {
+		mock.ExpectExec(expectedSQL).
+			WithArgs([]byte("response"), int64(86400), "user1", "key1", []byte("hash")).
+			WillReturnError(errors.New("query error"))
+
+		err := repo.PutResponse(context.Background(), "user1", "key1", entity.StoredResponse{RequestHash: []byte("hash"), Response: []byte("response")}, 24*time.Hour)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to put idempotency key")
+	})
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestReleaseKey(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewIdempotencyRepositoryImpl(db)
+
+	mock.ExpectExec("DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND response IS NULL").
+		WithArgs("user1", "key1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.ReleaseKey(context.Background(), "user1", "key1")
+
+	require.NoError(t, err)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewIdempotencyRepositoryImpl(db)
+
+	mock.ExpectExec("DELETE FROM idempotency_keys WHERE expires_at <= NOW() LIMIT ?").
+		WithArgs(1000).
+		WillReturnResult(sqlmock.NewResult(0, 42))
+
+	deleted, err := repo.DeleteExpired(context.Background(), 1000)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), deleted)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type OutboxRepository interface {
+	// ListUnpublished returns the oldest events that haven't been published yet, in the order they were written,
+	// skipping the events of the excluded recipients
+	ListUnpublished(ctx context.Context, excludedRecipients []string, limit int) ([]entity.OutboxEvent, error)
+
+	MarkPublished(ctx context.Context, ids []int64) error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOutboxRepository is a mock implementation of OutboxRepository
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+// Ensure MockOutboxRepository implements OutboxRepository interface
+var _ OutboxRepository = (*MockOutboxRepository)(nil)
+
+func (m *MockOutboxRepository) ListUnpublished(ctx context.Context, excludedRecipients []string, limit int) ([]entity.OutboxEvent, error) {
+	args := m.Called(ctx, excludedRecipients, limit)
+
+	var events []entity.OutboxEvent
+	if args.Get(0) != nil {
+		events = args.Get(0).([]entity.OutboxEvent)
+	}
+
+	return events, args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, ids []int64) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
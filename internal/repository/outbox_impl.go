@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+// outboxEventTypeValues are the values of the event_type column of decision_outbox
+var outboxEventTypeValues = map[entity.OutboxEventType]string{
+	entity.OutboxEventLike:  "like",
+	entity.OutboxEventPass:  "pass",
+	entity.OutboxEventMatch: "match",
+}
+
+type OutboxRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewOutboxRepositoryImpl(db *sql.DB) OutboxRepository {
+	return OutboxRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r OutboxRepositoryImpl) ListUnpublished(ctx context.Context, excludedRecipients []string, limit int) ([]entity.OutboxEvent, error) {
+	// A recipient's next event is only written once their last one has committed, see lockOutboxRecipients,
+	// so reading in ID order can't pass one of theirs that hasn't committed yet
+	query := `
+		SELECT id, event_type, actor_id, recipient_id, sequence, decision_type, created_at
+		FROM decision_outbox
+		WHERE published_at IS NULL`
+	var args []interface{}
+
+	if len(excludedRecipients) > 0 {
+		query += " AND recipient_id NOT IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(excludedRecipients)), ", ") + ")"
+		for _, id := range excludedRecipients {
+			args = append(args, id)
+		}
+	}
+
+	query += " ORDER BY id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var events []entity.OutboxEvent
+	for rows.Next() {
+		var event entity.OutboxEvent
+		var eventType string
+		var decisionType sql.NullString
+		if err := rows.Scan(&event.ID, &eventType, &event.ActorID, &event.RecipientID, &event.Sequence, &decisionType, &event.CreatedAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+
+		if event.Type, err = parseOutboxEventType(eventType); err != nil {
+			return nil, err
+		}
+		if decisionType.Valid {
+			parsed, err := parseDecisionType(decisionType.String)
+			if err != nil {
+				return nil, err
+			}
+			event.DecisionType = &parsed
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r OutboxRepositoryImpl) MarkPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	query := `UPDATE decision_outbox SET published_at = NOW(6) WHERE id IN (` + placeholders + `)`
+
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return dbErrorf("failed to mark events published: %w", err)
+	}
+	return nil
+}
+
+// lockOutboxRecipients locks the sequence rows of the recipients the transaction will write events for, until it
+// commits. Another transaction writing an event for one of them waits, so each recipient's events commit in the
+// order they're written. The rows are locked in order, so transactions locking several can't deadlock on them.
+func lockOutboxRecipients(ctx context.Context, tx *sql.Tx, recipientIDs []string) error {
+	recipientIDs = slices.Clone(recipientIDs)
+	slices.Sort(recipientIDs)
+	recipientIDs = slices.Compact(recipientIDs)
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?, 0), ", len(recipientIDs)), ", ")
+	query := `
+		INSERT INTO decision_outbox_sequences (recipient_id, last_sequence)
+		VALUES ` + placeholders + `
+		ON DUPLICATE KEY UPDATE last_sequence = last_sequence`
+
+	args := make([]interface{}, 0, len(recipientIDs))
+	for _, id := range recipientIDs {
+		args = append(args, id)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return dbErrorf("failed to lock outbox recipients: %w", err)
+	}
+	return nil
+}
+
+// enqueueOutboxEvent writes the event to the outbox within the transaction, so it is only published if the transaction commits.
+// The recipient must have been locked with lockOutboxRecipients first.
+func enqueueOutboxEvent(ctx context.Context, tx *sql.Tx, event entity.OutboxEvent) error {
+	var decisionType sql.NullString
+	if event.DecisionType != nil {
+		decisionType = sql.NullString{String: decisionTypeValues[*event.DecisionType], Valid: true}
+	}
+
+	sequenceQuery := "UPDATE decision_outbox_sequences SET last_sequence = last_sequence + 1 WHERE recipient_id = ?"
+	if _, err := tx.ExecContext(ctx, sequenceQuery, event.RecipientID); err != nil {
+		return dbErrorf("failed to number %s event: %w", outboxEventTypeValues[event.Type], err)
+	}
+
+	query := `
+		INSERT INTO decision_outbox (event_type, actor_id, recipient_id, sequence, decision_type, created_at)
+		SELECT ?, ?, recipient_id, last_sequence, ?, NOW(6)
+		FROM decision_outbox_sequences
+		WHERE recipient_id = ?`
+
+	_, err := tx.ExecContext(ctx, query, outboxEventTypeValues[event.Type], event.ActorID, decisionType, event.RecipientID)
+	if err != nil {
+		return dbErrorf("failed to enqueue %s event: %w", outboxEventTypeValues[event.Type], err)
+	}
+	return nil
+}
+
+// parseOutboxEventType returns the event type stored as the value of the event_type column
+func parseOutboxEventType(value string) (entity.OutboxEventType, error) {
+	for eventType, v := range outboxEventTypeValues {
+		if v == value {
+			return eventType, nil
+		}
+	}
+	return 0, dbErrorf("unknown outbox event type %q", value)
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUnpublished(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewOutboxRepositoryImpl(db)
+
+	// Setup expected query and response
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "event_type", "actor_id", "recipient_id", "sequence", "decision_type", "created_at"}).
+		AddRow(1, "like", "actor1", "recipient1", 1, "super_like", createdAt).
+		AddRow(2, "match", "actor1", "recipient1", 2, nil, createdAt)
+
+	expectedSQL := "SELECT id, event_type, actor_id, recipient_id, sequence, decision_type, created_at FROM decision_outbox WHERE published_at IS NULL AND recipient_id NOT IN (?, ?) ORDER BY id LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("recipient2", "recipient3", 100).
+		WillReturnRows(rows)
+
+	// Call the method
+	events, err := repo.ListUnpublished(context.Background(), []string{"recipient2", "recipient3"}, 100)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, entity.OutboxEventLike, events[0].Type)
+	require.NotNil(t, events[0].DecisionType)
+	assert.Equal(t, entity.DecisionTypeSuperLike, *events[0].DecisionType)
+	assert.Equal(t, entity.OutboxEventMatch, events[1].Type)
+	assert.Equal(t, int64(2), events[1].Sequence)
+	assert.Nil(t, events[1].DecisionType)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestMarkPublished(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewOutboxRepositoryImpl(db)
+
+	mock.ExpectExec("UPDATE decision_outbox SET published_at = NOW(6) WHERE id IN (?, ?)").
+		WithArgs(int64(1), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	// Call the method, nothing to mark doesn't touch the database
+	require.NoError(t, repo.MarkPublished(context.Background(), []int64{1, 3}))
+	require.NoError(t, repo.MarkPublished(context.Background(), nil))
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestLockOutboxRecipients(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// The recipients are locked once each and in order, so transactions can't deadlock on them
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO decision_outbox_sequences (recipient_id, last_sequence) VALUES (?, 0), (?, 0) ON DUPLICATE KEY UPDATE last_sequence = last_sequence").
+		WithArgs("recipient1", "recipient2").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	// Call the function
+	recipientIDs := []string{"recipient2", "recipient1", "recipient2"}
+	require.NoError(t, lockOutboxRecipients(context.Background(), tx, recipientIDs))
+
+	// Assert the caller's slice is left alone
+	assert.Equal(t, []string{"recipient2", "recipient1", "recipient2"}, recipientIDs)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
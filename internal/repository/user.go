@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+)
+
+type UserLookup interface {
+	// FindMissingUsers returns the IDs of users that don't exist
+	FindMissingUsers(ctx context.Context, userIDs []string) ([]string, error)
+}
+
+// UncheckedUserLookup treats every user as existing, for when the user table isn't the source of truth
+type UncheckedUserLookup struct{}
+
+func (UncheckedUserLookup) FindMissingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	return nil, nil
+}
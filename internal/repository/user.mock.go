@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserLookup is a mock implementation of UserLookup
+type MockUserLookup struct {
+	mock.Mock
+}
+
+// Ensure MockUserLookup implements UserLookup interface
+var _ UserLookup = (*MockUserLookup)(nil)
+
+func (m *MockUserLookup) FindMissingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	args := m.Called(ctx, userIDs)
+
+	var missing []string
+	if args.Get(0) != nil {
+		missing = args.Get(0).([]string)
+	}
+
+	return missing, args.Error(1)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachingUserLookup remembers which users exist for a while, so deciding on the same users doesn't query the user table every time.
+// Missing users aren't cached, so a user is found as soon as they're created, but a deleted user can still be found until their entry expires.
+type CachingUserLookup struct {
+	lookup UserLookup
+	ttl    time.Duration
+	// maxSize is the most users remembered at once
+	maxSize int
+
+	mu sync.Mutex
+	// existing holds when each user known to exist should be looked up again
+	existing map[string]time.Time
+}
+
+// Ensure CachingUserLookup implements UserLookup interface
+var _ UserLookup = (*CachingUserLookup)(nil)
+
+func NewCachingUserLookup(lookup UserLookup, ttl time.Duration, maxSize int) *CachingUserLookup {
+	return &CachingUserLookup{
+		lookup:   lookup,
+		ttl:      ttl,
+		maxSize:  maxSize,
+		existing: make(map[string]time.Time),
+	}
+}
+
+func (c *CachingUserLookup) FindMissingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	uncached := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if expiresAt, ok := c.existing[userID]; !ok || !now.Before(expiresAt) {
+			uncached = append(uncached, userID)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(uncached) == 0 {
+		return nil, nil
+	}
+
+	missing, err := c.lookup.FindMissingUsers(ctx, uncached)
+	if err != nil {
+		return nil, err
+	}
+
+	isMissing := make(map[string]bool, len(missing))
+	for _, userID := range missing {
+		isMissing[userID] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, userID := range uncached {
+		if isMissing[userID] {
+			continue
+		}
+		if len(c.existing) >= c.maxSize {
+			c.evict(now)
+		}
+		c.existing[userID] = now.Add(c.ttl)
+	}
+
+	return missing, nil
+}
+
+// evict makes room by dropping expired users, or forgetting everyone if none have expired
+func (c *CachingUserLookup) evict(now time.Time) {
+	for userID, expiresAt := range c.existing {
+		if !now.Before(expiresAt) {
+			delete(c.existing, userID)
+		}
+	}
+	if len(c.existing) >= c.maxSize {
+		c.existing = make(map[string]time.Time)
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingUserLookup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CachesExistingUsers", func(t *testing.T) {
+		lookup := new(MockUserLookup)
+		cache := NewCachingUserLookup(lookup, time.Hour, 10)
+
+		lookup.On("FindMissingUsers", mock.Anything, []string{"1", "2"}).Return([]string{"2"}, nil).Once()
+		lookup.On("FindMissingUsers", mock.Anything, []string{"2"}).Return(nil, nil).Once()
+
+		missing, err := cache.FindMissingUsers(ctx, []string{"1", "2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2"}, missing)
+
+		// User 1 is remembered, user 2 is looked up again in case they've been created since
+		missing, err = cache.FindMissingUsers(ctx, []string{"1", "2"})
+		require.NoError(t, err)
+		assert.Empty(t, missing)
+
+		missing, err = cache.FindMissingUsers(ctx, []string{"1", "2"})
+		require.NoError(t, err)
+		assert.Empty(t, missing)
+		lookup.AssertExpectations(t)
+	})
+
+	t.Run("ExpiresUsers", func(t *testing.T) {
+		lookup := new(MockUserLookup)
+		cache := NewCachingUserLookup(lookup, -time.Second, 10)
+
+		lookup.On("FindMissingUsers", mock.Anything, []string{"1"}).Return(nil, nil).Twice()
+
+		_, err := cache.FindMissingUsers(ctx, []string{"1"})
+		require.NoError(t, err)
+		_, err = cache.FindMissingUsers(ctx, []string{"1"})
+		require.NoError(t, err)
+		lookup.AssertExpectations(t)
+	})
+
+	t.Run("StaysWithinMaxSize", func(t *testing.T) {
+		lookup := new(MockUserLookup)
+		cache := NewCachingUserLookup(lookup, time.Hour, 2)
+
+		lookup.On("FindMissingUsers", mock.Anything, []string{"1", "2", "3"}).Return(nil, nil)
+
+		_, err := cache.FindMissingUsers(ctx, []string{"1", "2", "3"})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(cache.existing), 2)
+	})
+
+	t.Run("LookupError", func(t *testing.T) {
+		lookup := new(MockUserLookup)
+		cache := NewCachingUserLookup(lookup, time.Hour, 10)
+
+		lookup.On("FindMissingUsers", mock.Anything, []string{"1"}).Return(nil, errors.New("database error"))
+
+		_, err := cache.FindMissingUsers(ctx, []string{"1"})
+		require.Error(t, err)
+		assert.Empty(t, cache.existing)
+	})
+}
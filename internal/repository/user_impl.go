@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+)
+
+type UserLookupImpl struct {
+	db *sql.DB
+}
+
+func NewUserLookupImpl(db *sql.DB) UserLookup {
+	return UserLookupImpl{
+		db: db,
+	}
+}
+
+func (r UserLookupImpl) FindMissingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	// The user table has INT IDs, and MySQL would compare "12abc" equal to 12, so only IDs written
+	// exactly as an integer can exist
+	var ids []interface{}
+	for _, userID := range userIDs {
+		if id, err := strconv.ParseInt(userID, 10, 64); err == nil && strconv.FormatInt(id, 10) == userID {
+			ids = append(ids, id)
+		}
+	}
+
+	existing := make(map[string]bool, len(ids))
+	if len(ids) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+		query := "SELECT id FROM `user` WHERE id IN (" + placeholders + ")"
+
+		rows, err := r.db.QueryContext(ctx, query, ids...)
+		if err != nil {
+			return nil, dbErrorf("database query failed: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				return nil, dbErrorf("failed to scan row: %w", err)
+			}
+			existing[strconv.FormatInt(id, 10)] = true
+		}
+
+		if err := rows.Err(); err != nil {
+			return nil, dbErrorf("error iterating rows: %w", err)
+		}
+	}
+
+	var missing []string
+	for _, userID := range userIDs {
+		if !existing[userID] {
+			missing = append(missing, userID)
+		}
+	}
+	return missing, nil
+}
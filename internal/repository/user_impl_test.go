@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindMissingUsers(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewUserLookupImpl(db)
+
+	// Setup expected query and response, IDs that aren't integers can't exist so aren't looked up
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(12)
+
+	expectedSQL := "SELECT id FROM `user` WHERE id IN (?, ?)"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(int64(12), int64(13)).
+		WillReturnRows(rows)
+
+	// Call the method
+	missing, err := repo.FindMissingUsers(context.Background(), []string{"12", "13", "12abc", "012"})
+
+	// Assert results
+	require.NoError(t, err)
+	assert.Equal(t, []string{"13", "12abc", "012"}, missing)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
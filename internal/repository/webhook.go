@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type WebhookRepository interface {
+	CreateEndpoint(ctx context.Context, endpoint entity.WebhookEndpoint) (int64, error)
+
+	// DeleteEndpoint removes the endpoint, its queued deliveries and its dead letters, reporting whether it existed
+	DeleteEndpoint(ctx context.Context, endpointID int64) (bool, error)
+
+	// GetEndpoint returns the endpoint, nil if it doesn't exist
+	GetEndpoint(ctx context.Context, endpointID int64) (*entity.WebhookEndpoint, error)
+
+	// ListEndpointsForEvent returns the endpoints that receive the type of event
+	ListEndpointsForEvent(ctx context.Context, eventType entity.OutboxEventType) ([]entity.WebhookEndpoint, error)
+
+	// EnqueueDeliveries queues the deliveries to be attempted straight away.
+	// A delivery already queued for the same endpoint and event is left as it is, so publishing an event again doesn't
+	// queue it twice.
+	EnqueueDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error
+
+	// ListDueDeliveries returns the deliveries whose next attempt is due, longest overdue first
+	ListDueDeliveries(ctx context.Context, limit int) ([]entity.WebhookDelivery, error)
+
+	// DeleteDelivery removes a delivery once it has succeeded
+	DeleteDelivery(ctx context.Context, deliveryID int64) error
+
+	// RetryDelivery counts another failed attempt at the delivery and schedules the next one after the delay
+	RetryDelivery(ctx context.Context, deliveryID int64, delay time.Duration, lastError string) error
+
+	// DeadLetterDelivery replaces the delivery with the dead letter, once it won't be retried
+	DeadLetterDelivery(ctx context.Context, deliveryID int64, deadLetter entity.DeadLetter) error
+
+	// ListDeadLetters returns the oldest dead letters that haven't been replayed, only for one endpoint if its ID is given
+	ListDeadLetters(ctx context.Context, endpointID *int64, limit int) ([]entity.DeadLetter, error)
+
+	MarkDeadLetterReplayed(ctx context.Context, deadLetterID int64) error
+
+	// RecordFailedReplay counts another failed attempt at delivering the dead letter
+	RecordFailedReplay(ctx context.Context, deadLetterID int64, lastError string) error
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookRepository is a mock implementation of WebhookRepository
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+// Ensure MockWebhookRepository implements WebhookRepository interface
+var _ WebhookRepository = (*MockWebhookRepository)(nil)
+
+func (m *MockWebhookRepository) CreateEndpoint(ctx context.Context, endpoint entity.WebhookEndpoint) (int64, error) {
+	args := m.Called(ctx, endpoint)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockWebhookRepository) DeleteEndpoint(ctx context.Context, endpointID int64) (bool, error) {
+	args := m.Called(ctx, endpointID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetEndpoint(ctx context.Context, endpointID int64) (*entity.WebhookEndpoint, error) {
+	args := m.Called(ctx, endpointID)
+
+	var endpoint *entity.WebhookEndpoint
+	if args.Get(0) != nil {
+		endpoint = args.Get(0).(*entity.WebhookEndpoint)
+	}
+
+	return endpoint, args.Error(1)
+}
+
+func (m *MockWebhookRepository) ListEndpointsForEvent(ctx context.Context, eventType entity.OutboxEventType) ([]entity.WebhookEndpoint, error) {
+	args := m.Called(ctx, eventType)
+
+	var endpoints []entity.WebhookEndpoint
+	if args.Get(0) != nil {
+		endpoints = args.Get(0).([]entity.WebhookEndpoint)
+	}
+
+	return endpoints, args.Error(1)
+}
+
+func (m *MockWebhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) ListDueDeliveries(ctx context.Context, limit int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, limit)
+
+	var deliveries []entity.WebhookDelivery
+	if args.Get(0) != nil {
+		deliveries = args.Get(0).([]entity.WebhookDelivery)
+	}
+
+	return deliveries, args.Error(1)
+}
+
+func (m *MockWebhookRepository) DeleteDelivery(ctx context.Context, deliveryID int64) error {
+	args := m.Called(ctx, deliveryID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) RetryDelivery(ctx context.Context, deliveryID int64, delay time.Duration, lastError string) error {
+	args := m.Called(ctx, deliveryID, delay, lastError)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) DeadLetterDelivery(ctx context.Context, deliveryID int64, deadLetter entity.DeadLetter) error {
+	args := m.Called(ctx, deliveryID, deadLetter)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) ListDeadLetters(ctx context.Context, endpointID *int64, limit int) ([]entity.DeadLetter, error) {
+	args := m.Called(ctx, endpointID, limit)
+
+	var deadLetters []entity.DeadLetter
+	if args.Get(0) != nil {
+		deadLetters = args.Get(0).([]entity.DeadLetter)
+	}
+
+	return deadLetters, args.Error(1)
+}
+
+func (m *MockWebhookRepository) MarkDeadLetterReplayed(ctx context.Context, deadLetterID int64) error {
+	args := m.Called(ctx, deadLetterID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) RecordFailedReplay(ctx context.Context, deadLetterID int64, lastError string) error {
+	args := m.Called(ctx, deadLetterID, lastError)
+	return args.Error(0)
+}
@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+type WebhookRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewWebhookRepositoryImpl(db *sql.DB) WebhookRepository {
+	return WebhookRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r WebhookRepositoryImpl) CreateEndpoint(ctx context.Context, endpoint entity.WebhookEndpoint) (int64, error) {
+	eventTypes := make([]string, 0, len(endpoint.EventTypes))
+	for _, eventType := range endpoint.EventTypes {
+		eventTypes = append(eventTypes, outboxEventTypeValues[eventType])
+	}
+
+	query := `
+		INSERT INTO webhook_endpoints (url, secret, event_types, created_at)
+		VALUES (?, ?, ?, NOW())`
+
+	result, err := r.db.ExecContext(ctx, query, endpoint.URL, endpoint.Secret, strings.Join(eventTypes, ","))
+	if err != nil {
+		return 0, dbErrorf("failed to create webhook endpoint: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, dbErrorf("failed to create webhook endpoint: %w", err)
+	}
+	return id, nil
+}
+
+func (r WebhookRepositoryImpl) DeleteEndpoint(ctx context.Context, endpointID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE endpoint_id = ?", endpointID); err != nil {
+		return false, dbErrorf("failed to delete deliveries: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook_dead_letters WHERE endpoint_id = ?", endpointID); err != nil {
+		return false, dbErrorf("failed to delete dead letters: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM webhook_endpoints WHERE id = ?", endpointID)
+	if err != nil {
+		return false, dbErrorf("failed to delete webhook endpoint: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, dbErrorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return false, dbErrorf("failed to commit transaction: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+func (r WebhookRepositoryImpl) GetEndpoint(ctx context.Context, endpointID int64) (*entity.WebhookEndpoint, error) {
+	query := "SELECT id, url, secret, event_types, created_at FROM webhook_endpoints WHERE id = ?"
+
+	rows, err := r.db.QueryContext(ctx, query, endpointID)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints, err := scanWebhookEndpoints(rows)
+	if err != nil || len(endpoints) == 0 {
+		return nil, err
+	}
+	return &endpoints[0], nil
+}
+
+func (r WebhookRepositoryImpl) ListEndpointsForEvent(ctx context.Context, eventType entity.OutboxEventType) ([]entity.WebhookEndpoint, error) {
+	query := "SELECT id, url, secret, event_types, created_at FROM webhook_endpoints WHERE FIND_IN_SET(?, event_types) > 0 ORDER BY id"
+
+	rows, err := r.db.QueryContext(ctx, query, outboxEventTypeValues[eventType])
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWebhookEndpoints(rows)
+}
+
+// scanWebhookEndpoints reads every endpoint from the rows
+func scanWebhookEndpoints(rows *sql.Rows) ([]entity.WebhookEndpoint, error) {
+	var endpoints []entity.WebhookEndpoint
+	for rows.Next() {
+		var endpoint entity.WebhookEndpoint
+		var eventTypes string
+		if err := rows.Scan(&endpoint.ID, &endpoint.URL, &endpoint.Secret, &eventTypes, &endpoint.CreatedAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+
+		for _, value := range strings.Split(eventTypes, ",") {
+			eventType, err := parseOutboxEventType(value)
+			if err != nil {
+				return nil, err
+			}
+			endpoint.EventTypes = append(endpoint.EventTypes, eventType)
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+func (r WebhookRepositoryImpl) EnqueueDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?, ?, ?, NOW(6), NOW()), ", len(deliveries)), ", ")
+	query := `
+		INSERT INTO webhook_deliveries (endpoint_id, event_id, payload, next_attempt_at, created_at)
+		VALUES ` + placeholders + `
+		ON DUPLICATE KEY UPDATE id = id`
+
+	args := make([]interface{}, 0, 3*len(deliveries))
+	for _, delivery := range deliveries {
+		args = append(args, delivery.Endpoint.ID, delivery.EventID, delivery.Payload)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return dbErrorf("failed to enqueue deliveries: %w", err)
+	}
+	return nil
+}
+
+func (r WebhookRepositoryImpl) ListDueDeliveries(ctx context.Context, limit int) ([]entity.WebhookDelivery, error) {
+	query := `
+		SELECT d.id, d.event_id, d.payload, d.attempts, e.id, e.url, e.secret
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE d.next_attempt_at <= NOW(6)
+		ORDER BY d.next_attempt_at, d.id
+		LIMIT ?`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		if err := rows.Scan(&delivery.ID, &delivery.EventID, &delivery.Payload, &delivery.Attempts, &delivery.Endpoint.ID, &delivery.Endpoint.URL, &delivery.Endpoint.Secret); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r WebhookRepositoryImpl) DeleteDelivery(ctx context.Context, deliveryID int64) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE id = ?", deliveryID); err != nil {
+		return dbErrorf("failed to delete delivery: %w", err)
+	}
+	return nil
+}
+
+func (r WebhookRepositoryImpl) RetryDelivery(ctx context.Context, deliveryID int64, delay time.Duration, lastError string) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET attempts = attempts + 1, last_error = ?, next_attempt_at = NOW(6) + INTERVAL ? MICROSECOND
+		WHERE id = ?`
+
+	if _, err := r.db.ExecContext(ctx, query, lastError, delay.Microseconds(), deliveryID); err != nil {
+		return dbErrorf("failed to schedule delivery retry: %w", err)
+	}
+	return nil
+}
+
+func (r WebhookRepositoryImpl) DeadLetterDelivery(ctx context.Context, deliveryID int64, deadLetter entity.DeadLetter) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return dbErrorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	query := `
+		INSERT INTO webhook_dead_letters (endpoint_id, event_id, payload, attempts, last_error, created_at)
+		VALUES (?, ?, ?, ?, ?, NOW())`
+
+	_, err = tx.ExecContext(ctx, query, deadLetter.EndpointID, deadLetter.EventID, deadLetter.Payload, deadLetter.Attempts, deadLetter.LastError)
+	if err != nil {
+		return dbErrorf("failed to put dead letter: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE id = ?", deliveryID); err != nil {
+		return dbErrorf("failed to delete delivery: %w", err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return dbErrorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r WebhookRepositoryImpl) ListDeadLetters(ctx context.Context, endpointID *int64, limit int) ([]entity.DeadLetter, error) {
+	query := "SELECT id, endpoint_id, event_id, payload, attempts, last_error, created_at FROM webhook_dead_letters WHERE replayed_at IS NULL"
+	var args []interface{}
+
+	if endpointID != nil {
+		query += " AND endpoint_id = ?"
+		args = append(args, *endpointID)
+	}
+
+	query += " ORDER BY id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbErrorf("database query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var deadLetters []entity.DeadLetter
+	for rows.Next() {
+		var deadLetter entity.DeadLetter
+		if err := rows.Scan(&deadLetter.ID, &deadLetter.EndpointID, &deadLetter.EventID, &deadLetter.Payload, &deadLetter.Attempts, &deadLetter.LastError, &deadLetter.CreatedAt); err != nil {
+			return nil, dbErrorf("failed to scan row: %w", err)
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbErrorf("error iterating rows: %w", err)
+	}
+
+	return deadLetters, nil
+}
+
+func (r WebhookRepositoryImpl) MarkDeadLetterReplayed(ctx context.Context, deadLetterID int64) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE webhook_dead_letters SET replayed_at = NOW() WHERE id = ?", deadLetterID)
+	if err != nil {
+		return dbErrorf("failed to mark dead letter replayed: %w", err)
+	}
+	return nil
+}
+
+func (r WebhookRepositoryImpl) RecordFailedReplay(ctx context.Context, deadLetterID int64, lastError string) error {
+	query := "UPDATE webhook_dead_letters SET attempts = attempts + 1, last_error = ? WHERE id = ?"
+
+	result, err := r.db.ExecContext(ctx, query, lastError, deadLetterID)
+	if err != nil {
+		return dbErrorf("failed to record failed replay: %w", err)
+	}
+	if updated, err := result.RowsAffected(); err == nil && updated == 0 {
+		return dbErrorf("failed to record failed replay: dead letter %w", ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEndpoint(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query and response
+	expectedSQL := "INSERT INTO webhook_endpoints (url, secret, event_types, created_at) VALUES (?, ?, ?, NOW())"
+	mock.ExpectExec(expectedSQL).
+		WithArgs("https://partner.example.com/hooks", []byte("secret"), "like,match").
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// Call the method
+	id, err := repo.CreateEndpoint(context.Background(), entity.WebhookEndpoint{
+		URL:        "https://partner.example.com/hooks",
+		Secret:     []byte("secret"),
+		EventTypes: []entity.OutboxEventType{entity.OutboxEventLike, entity.OutboxEventMatch},
+	})
+
+	// Assert results
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), id)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteEndpoint(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected queries
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM webhook_deliveries WHERE endpoint_id = ?").
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM webhook_dead_letters WHERE endpoint_id = ?").
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM webhook_endpoints WHERE id = ?").
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the method
+	deleted, err := repo.DeleteEndpoint(context.Background(), 3)
+
+	// Assert results
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListEndpointsForEvent(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query and response
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "url", "secret", "event_types", "created_at"}).
+		AddRow(1, "https://a.example.com", []byte("secret1"), "match", createdAt).
+		AddRow(2, "https://b.example.com", []byte("secret2"), "like,match", createdAt)
+
+	expectedSQL := "SELECT id, url, secret, event_types, created_at FROM webhook_endpoints WHERE FIND_IN_SET(?, event_types) > 0 ORDER BY id"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("match").
+		WillReturnRows(rows)
+
+	// Call the method
+	endpoints, err := repo.ListEndpointsForEvent(context.Background(), entity.OutboxEventMatch)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, endpoints, 2)
+	assert.Equal(t, []entity.OutboxEventType{entity.OutboxEventMatch}, endpoints[0].EventTypes)
+	assert.Equal(t, []entity.OutboxEventType{entity.OutboxEventLike, entity.OutboxEventMatch}, endpoints[1].EventTypes)
+	assert.Equal(t, []byte("secret2"), endpoints[1].Secret)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListDeadLetters(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query and response
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "endpoint_id", "event_id", "payload", "attempts", "last_error", "created_at"}).
+		AddRow(5, 2, 7, []byte(`{"id":7}`), 5, "webhook 2 responded 503 Service Unavailable", createdAt)
+
+	expectedSQL := "SELECT id, endpoint_id, event_id, payload, attempts, last_error, created_at FROM webhook_dead_letters WHERE replayed_at IS NULL AND endpoint_id = ? ORDER BY id LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(int64(2), 50).
+		WillReturnRows(rows)
+
+	// Call the method
+	endpointID := int64(2)
+	deadLetters, err := repo.ListDeadLetters(context.Background(), &endpointID, 50)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, int64(7), deadLetters[0].EventID)
+	assert.Equal(t, []byte(`{"id":7}`), deadLetters[0].Payload)
+	assert.Equal(t, 5, deadLetters[0].Attempts)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestEnqueueDeliveries(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query, a delivery already queued for the endpoint and event is kept
+	expectedSQL := "INSERT INTO webhook_deliveries (endpoint_id, event_id, payload, next_attempt_at, created_at) VALUES (?, ?, ?, NOW(6), NOW()), (?, ?, ?, NOW(6), NOW()) ON DUPLICATE KEY UPDATE id = id"
+	mock.ExpectExec(expectedSQL).
+		WithArgs(int64(1), int64(7), []byte(`{"id":7}`), int64(2), int64(7), []byte(`{"id":7}`)).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	// Call the method
+	err = repo.EnqueueDeliveries(context.Background(), []entity.WebhookDelivery{
+		{Endpoint: entity.WebhookEndpoint{ID: 1}, EventID: 7, Payload: []byte(`{"id":7}`)},
+		{Endpoint: entity.WebhookEndpoint{ID: 2}, EventID: 7, Payload: []byte(`{"id":7}`)},
+	})
+
+	// Assert results
+	require.NoError(t, err)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestListDueDeliveries(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query and response
+	rows := sqlmock.NewRows([]string{"id", "event_id", "payload", "attempts", "endpoint_id", "url", "secret"}).
+		AddRow(4, 7, []byte(`{"id":7}`), 2, 1, "https://partner.example.com/hooks", []byte("secret"))
+
+	expectedSQL := "SELECT d.id, d.event_id, d.payload, d.attempts, e.id, e.url, e.secret FROM webhook_deliveries d JOIN webhook_endpoints e ON e.id = d.endpoint_id WHERE d.next_attempt_at <= NOW(6) ORDER BY d.next_attempt_at, d.id LIMIT ?"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(100).
+		WillReturnRows(rows)
+
+	// Call the method
+	deliveries, err := repo.ListDueDeliveries(context.Background(), 100)
+
+	// Assert results
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, int64(4), deliveries[0].ID)
+	assert.Equal(t, int64(7), deliveries[0].EventID)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.Equal(t, "https://partner.example.com/hooks", deliveries[0].Endpoint.URL)
+	assert.Equal(t, []byte("secret"), deliveries[0].Endpoint.Secret)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRetryDelivery(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected query
+	expectedSQL := "UPDATE webhook_deliveries SET attempts = attempts + 1, last_error = ?, next_attempt_at = NOW(6) + INTERVAL ? MICROSECOND WHERE id = ?"
+	mock.ExpectExec(expectedSQL).
+		WithArgs("webhook 1 responded 503 Service Unavailable", int64(1500000), int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the method
+	err = repo.RetryDelivery(context.Background(), 4, 1500*time.Millisecond, "webhook 1 responded 503 Service Unavailable")
+
+	// Assert results
+	require.NoError(t, err)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeadLetterDelivery(t *testing.T) {
+	// Create a new mock database connection with QueryMatcherEqual
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create the repository with the mock db
+	repo := NewWebhookRepositoryImpl(db)
+
+	// Setup expected queries, the dead letter replaces the delivery
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO webhook_dead_letters (endpoint_id, event_id, payload, attempts, last_error, created_at) VALUES (?, ?, ?, ?, ?, NOW())").
+		WithArgs(int64(1), int64(7), []byte(`{"id":7}`), 8, "webhook 1 responded 503 Service Unavailable").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("DELETE FROM webhook_deliveries WHERE id = ?").
+		WithArgs(int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the method
+	err = repo.DeadLetterDelivery(context.Background(), 4, entity.DeadLetter{
+		EndpointID: 1,
+		EventID:    7,
+		Payload:    []byte(`{"id":7}`),
+		Attempts:   8,
+		LastError:  "webhook 1 responded 503 Service Unavailable",
+	})
+
+	// Assert results
+	require.NoError(t, err)
+
+	// Ensure all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/webhook"
+	"github.com/shewitt93/explore_service/pkg/grpclibs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+)
+
+// webhookSecretLength is the size in bytes of the keys webhook payloads are signed with
+const webhookSecretLength = 32
+
+// maxTierLength is the longest tier name the user_entitlements table holds
+const maxTierLength = 32
+
+// ExploreAdminGRPCServer serves the RPCs used by internal tooling
+type ExploreAdminGRPCServer struct {
+	grpclibs.ExploreAdminServiceServer
+	repo         repository.DecisionRepository
+	webhooks     repository.WebhookRepository
+	entitlements repository.EntitlementRepository
+	deliverer    *webhook.Deliverer
+	config       Config
+}
+
+func NewExploreAdminGRPCServer(repo repository.DecisionRepository, webhooks repository.WebhookRepository, entitlements repository.EntitlementRepository, deliverer *webhook.Deliverer, config Config) *ExploreAdminGRPCServer {
+	return &ExploreAdminGRPCServer{
+		repo:         repo,
+		webhooks:     webhooks,
+		entitlements: entitlements,
+		deliverer:    deliverer,
+		config:       config,
+	}
+}
+
+func (s *ExploreAdminGRPCServer) ClearUnmatch(ctx context.Context, req *grpclibs.ClearUnmatchRequest) (*grpclibs.ClearUnmatchResponse, error) {
+	// Validate input
+	if req.GetUserAId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing user a id")
+	}
+	if req.GetUserBId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing user b id")
+	}
+
+	cleared, err := s.repo.ClearUnmatch(ctx, req.GetUserAId(), req.GetUserBId())
+	if err != nil {
+		return nil, failedTo("clear unmatch", err)
+	}
+	if !cleared {
+		return nil, status.Errorf(codes.NotFound, "users haven't unmatched")
+	}
+
+	return &grpclibs.ClearUnmatchResponse{}, nil
+}
+
+func (s *ExploreAdminGRPCServer) ListDecisionHistory(ctx context.Context, req *grpclibs.ListDecisionHistoryRequest) (*grpclibs.ListDecisionHistoryResponse, error) {
+	// Validate input
+	if req.GetActorUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing actor user id")
+	}
+	if req.GetRecipientUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing recipient user id")
+	}
+
+	// Handle pagination token if provided, it's only valid for the same pair of users
+	scope := entity.CursorScope{
+		List:   grpclibs.ExploreAdminService_ListDecisionHistory_FullMethodName + "?" + req.GetRecipientUserId(),
+		UserID: req.GetActorUserId(),
+	}
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.repo.ListDecisionHistory(ctx, req.GetActorUserId(), req.GetRecipientUserId(), cursor, pageSize)
+	if err != nil {
+		return nil, failedTo("fetch decision history", err)
+	}
+
+	response := &grpclibs.ListDecisionHistoryResponse{
+		Entries: make([]*grpclibs.ListDecisionHistoryResponse_Entry, 0, len(page.Items)),
+	}
+
+	for _, entry := range page.Items {
+		item := &grpclibs.ListDecisionHistoryResponse_Entry{
+			Change:        newDecisionChange(entry.Change),
+			UnixTimestamp: uint64(entry.CreatedAt.Unix()),
+		}
+		if entry.Type != nil {
+			decisionType := newDecisionType(*entry.Type)
+			item.DecisionType = &decisionType
+		}
+		response.Entries = append(response.Entries, item)
+	}
+
+	if response.NextPaginationToken, err = s.config.encodePaginationToken(page.NextCursor, scope); err != nil {
+		return nil, err
+	}
+	if response.PreviousPaginationToken, err = s.config.encodePaginationToken(page.PreviousCursor, scope); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *ExploreAdminGRPCServer) RegisterWebhook(ctx context.Context, req *grpclibs.RegisterWebhookRequest) (*grpclibs.RegisterWebhookResponse, error) {
+	// Validate input
+	endpointURL, err := url.Parse(req.GetUrl())
+	if err != nil || (endpointURL.Scheme != "https" && endpointURL.Scheme != "http") || endpointURL.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+	if !webhook.IsPublicHost(endpointURL.Hostname()) {
+		return nil, status.Errorf(codes.InvalidArgument, "url must not point at a loopback, link-local or private address")
+	}
+	if len(req.GetEventTypes()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "missing event types")
+	}
+
+	endpoint := entity.WebhookEndpoint{URL: endpointURL.String()}
+	seen := make(map[entity.OutboxEventType]bool)
+	for _, requested := range req.GetEventTypes() {
+		eventType, ok := parseWebhookEventType(requested)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event type: %v", requested)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			endpoint.EventTypes = append(endpoint.EventTypes, eventType)
+		}
+	}
+
+	endpoint.Secret = make([]byte, webhookSecretLength)
+	if _, err := rand.Read(endpoint.Secret); err != nil {
+		return nil, failedTo("generate webhook secret", err)
+	}
+
+	id, err := s.webhooks.CreateEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, failedTo("register webhook", err)
+	}
+
+	return &grpclibs.RegisterWebhookResponse{
+		WebhookId: uint64(id),
+		Secret:    base64.StdEncoding.EncodeToString(endpoint.Secret),
+	}, nil
+}
+
+func (s *ExploreAdminGRPCServer) DeleteWebhook(ctx context.Context, req *grpclibs.DeleteWebhookRequest) (*grpclibs.DeleteWebhookResponse, error) {
+	// Validate input
+	if req.GetWebhookId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "missing webhook id")
+	}
+
+	deleted, err := s.webhooks.DeleteEndpoint(ctx, int64(req.GetWebhookId()))
+	if err != nil {
+		return nil, failedTo("delete webhook", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+
+	return &grpclibs.DeleteWebhookResponse{}, nil
+}
+
+// ReplayDeadLetters sends each dead letter once more, oldest first.
+// Once a webhook fails again the rest of its dead letters are left for later, so they stay in order
+// and a webhook that's still down doesn't hold up the replay.
+func (s *ExploreAdminGRPCServer) ReplayDeadLetters(ctx context.Context, req *grpclibs.ReplayDeadLettersRequest) (*grpclibs.ReplayDeadLettersResponse, error) {
+	limit, err := s.config.resolvePageSize(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpointID *int64
+	if req.WebhookId != nil {
+		id := int64(req.GetWebhookId())
+		endpointID = &id
+	}
+
+	deadLetters, err := s.webhooks.ListDeadLetters(ctx, endpointID, limit)
+	if err != nil {
+		return nil, failedTo("fetch dead letters", err)
+	}
+
+	response := &grpclibs.ReplayDeadLettersResponse{}
+	endpoints := make(map[int64]*entity.WebhookEndpoint)
+	failing := make(map[int64]bool)
+	for _, deadLetter := range deadLetters {
+		if failing[deadLetter.EndpointID] {
+			response.FailedCount++
+			continue
+		}
+
+		endpoint, ok := endpoints[deadLetter.EndpointID]
+		if !ok {
+			if endpoint, err = s.webhooks.GetEndpoint(ctx, deadLetter.EndpointID); err != nil {
+				return nil, failedTo("fetch webhook", err)
+			}
+			endpoints[deadLetter.EndpointID] = endpoint
+		}
+		if endpoint == nil {
+			// Deleted while replaying, its dead letters went with it
+			continue
+		}
+
+		if err := s.deliverer.DeliverOnce(ctx, *endpoint, deadLetter.Payload); err != nil {
+			if err := s.webhooks.RecordFailedReplay(ctx, deadLetter.ID, err.Error()); err != nil {
+				return nil, failedTo("record failed replay", err)
+			}
+			failing[deadLetter.EndpointID] = true
+			response.FailedCount++
+			continue
+		}
+
+		if err := s.webhooks.MarkDeadLetterReplayed(ctx, deadLetter.ID); err != nil {
+			return nil, failedTo("mark dead letter replayed", err)
+		}
+		response.ReplayedCount++
+	}
+
+	return response, nil
+}
+
+// parseWebhookEventType returns the outbox event type for an API webhook event type
+func parseWebhookEventType(eventType grpclibs.WebhookEventType) (entity.OutboxEventType, bool) {
+	switch eventType {
+	case grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_LIKE:
+		return entity.OutboxEventLike, true
+	case grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_MATCH:
+		return entity.OutboxEventMatch, true
+	default:
+		return 0, false
+	}
+}
+
+// newDecisionChange returns the API change for an entity change
+func newDecisionChange(change entity.DecisionChange) grpclibs.DecisionChange {
+	switch change {
+	case entity.DecisionChangePut:
+		return grpclibs.DecisionChange_DECISION_CHANGE_PUT
+	case entity.DecisionChangeUndo:
+		return grpclibs.DecisionChange_DECISION_CHANGE_UNDO
+	case entity.DecisionChangeBlock:
+		return grpclibs.DecisionChange_DECISION_CHANGE_BLOCK
+	case entity.DecisionChangeUnmatch:
+		return grpclibs.DecisionChange_DECISION_CHANGE_UNMATCH
+	default:
+		return grpclibs.DecisionChange_DECISION_CHANGE_UNSPECIFIED
+	}
+}
+
+func (s *ExploreAdminGRPCServer) SetUserTier(ctx context.Context, req *grpclibs.SetUserTierRequest) (*grpclibs.SetUserTierResponse, error) {
+	// Validate input
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing user id")
+	}
+	if len(req.GetTier()) > maxTierLength {
+		return nil, status.Errorf(codes.InvalidArgument, "tier must be at most %d bytes", maxTierLength)
+	}
+
+	if err := s.entitlements.SetTier(ctx, req.GetUserId(), req.GetTier()); err != nil {
+		return nil, failedTo("set user tier", err)
+	}
+
+	return &grpclibs.SetUserTierResponse{}, nil
+}
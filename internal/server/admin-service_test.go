@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/webhook"
+	"github.com/shewitt93/explore_service/pkg/grpclibs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClearUnmatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotUnmatched", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreAdminGRPCServer(repo, new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		repo.On("ClearUnmatch", mock.Anything, "user1", "user2").Return(false, nil)
+
+		_, err := s.ClearUnmatch(ctx, &grpclibs.ClearUnmatchRequest{UserAId: "user1", UserBId: "user2"})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestListDecisionHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreAdminGRPCServer(repo, new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		like := entity.DecisionTypeLike
+		createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		page := &entity.Page[entity.DecisionHistoryEntry]{
+			Items: []entity.DecisionHistoryEntry{
+				{ID: 2, ActorID: "user1", RecipientID: "user2", Change: entity.DecisionChangeUndo, CreatedAt: createdAt},
+				{ID: 1, ActorID: "user1", RecipientID: "user2", Type: &like, Change: entity.DecisionChangePut, CreatedAt: createdAt.Add(-time.Minute)},
+			},
+		}
+		repo.On("ListDecisionHistory", mock.Anything, "user1", "user2", (*entity.Cursor)(nil), int(testConfig.DefaultPageSize)).Return(page, nil)
+
+		resp, err := s.ListDecisionHistory(ctx, &grpclibs.ListDecisionHistoryRequest{ActorUserId: "user1", RecipientUserId: "user2"})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Entries, 2)
+		assert.Equal(t, grpclibs.DecisionChange_DECISION_CHANGE_UNDO, resp.Entries[0].Change)
+		assert.Nil(t, resp.Entries[0].DecisionType)
+		assert.Equal(t, grpclibs.DecisionChange_DECISION_CHANGE_PUT, resp.Entries[1].Change)
+		assert.Equal(t, grpclibs.DecisionType_DECISION_TYPE_LIKE, resp.Entries[1].GetDecisionType())
+		assert.Equal(t, uint64(createdAt.Unix()), resp.Entries[0].UnixTimestamp)
+		assert.Nil(t, resp.NextPaginationToken)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("MissingRecipient", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreAdminGRPCServer(repo, new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		_, err := s.ListDecisionHistory(ctx, &grpclibs.ListDecisionHistoryRequest{ActorUserId: "user1"})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRegisterWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		webhooks := new(repository.MockWebhookRepository)
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), webhooks, new(repository.MockEntitlementRepository), nil, testConfig)
+
+		var secret []byte
+		webhooks.On("CreateEndpoint", mock.Anything, mock.MatchedBy(func(endpoint entity.WebhookEndpoint) bool {
+			secret = endpoint.Secret
+			return endpoint.URL == "https://partner.example.com/hooks" &&
+				assert.ObjectsAreEqual([]entity.OutboxEventType{entity.OutboxEventMatch}, endpoint.EventTypes)
+		})).Return(int64(3), nil)
+
+		resp, err := s.RegisterWebhook(ctx, &grpclibs.RegisterWebhookRequest{
+			Url: "https://partner.example.com/hooks",
+			EventTypes: []grpclibs.WebhookEventType{
+				grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_MATCH,
+				grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_MATCH,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resp.WebhookId)
+		assert.Len(t, secret, webhookSecretLength)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(secret), resp.Secret)
+		webhooks.AssertExpectations(t)
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		_, err := s.RegisterWebhook(ctx, &grpclibs.RegisterWebhookRequest{
+			Url:        "partner.example.com/hooks",
+			EventTypes: []grpclibs.WebhookEventType{grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_LIKE},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("PrivateURL", func(t *testing.T) {
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		for _, endpointURL := range []string{"http://localhost:8080/hooks", "http://127.0.0.1/hooks", "http://169.254.169.254/latest", "https://10.0.0.5/hooks", "http://[::1]/hooks"} {
+			_, err := s.RegisterWebhook(ctx, &grpclibs.RegisterWebhookRequest{
+				Url:        endpointURL,
+				EventTypes: []grpclibs.WebhookEventType{grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_LIKE},
+			})
+
+			require.Error(t, err, endpointURL)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), endpointURL)
+		}
+	})
+
+	t.Run("UnspecifiedEventType", func(t *testing.T) {
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), new(repository.MockWebhookRepository), new(repository.MockEntitlementRepository), nil, testConfig)
+
+		_, err := s.RegisterWebhook(ctx, &grpclibs.RegisterWebhookRequest{
+			Url:        "https://partner.example.com/hooks",
+			EventTypes: []grpclibs.WebhookEventType{grpclibs.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotFound", func(t *testing.T) {
+		webhooks := new(repository.MockWebhookRepository)
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), webhooks, new(repository.MockEntitlementRepository), nil, testConfig)
+
+		webhooks.On("DeleteEndpoint", mock.Anything, int64(3)).Return(false, nil)
+
+		_, err := s.DeleteWebhook(ctx, &grpclibs.DeleteWebhookRequest{WebhookId: 3})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		webhooks.AssertExpectations(t)
+	})
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SkipsRestOfFailingWebhook", func(t *testing.T) {
+		healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer healthy.Close()
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		webhooks := new(repository.MockWebhookRepository)
+		deliverer := webhook.NewDeliverer(http.DefaultClient, 1, time.Millisecond, time.Millisecond)
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), webhooks, new(repository.MockEntitlementRepository), deliverer, testConfig)
+
+		deadLetters := []entity.DeadLetter{
+			{ID: 1, EndpointID: 1, EventID: 7, Payload: []byte(`{"id":7}`)},
+			{ID: 2, EndpointID: 2, EventID: 7, Payload: []byte(`{"id":7}`)},
+			{ID: 3, EndpointID: 2, EventID: 8, Payload: []byte(`{"id":8}`)},
+		}
+		webhooks.On("ListDeadLetters", mock.Anything, (*int64)(nil), int(testConfig.DefaultPageSize)).Return(deadLetters, nil)
+		webhooks.On("GetEndpoint", mock.Anything, int64(1)).Return(&entity.WebhookEndpoint{ID: 1, URL: healthy.URL, Secret: []byte("secret1")}, nil)
+		webhooks.On("GetEndpoint", mock.Anything, int64(2)).Return(&entity.WebhookEndpoint{ID: 2, URL: failing.URL, Secret: []byte("secret2")}, nil)
+		webhooks.On("MarkDeadLetterReplayed", mock.Anything, int64(1)).Return(nil)
+		webhooks.On("RecordFailedReplay", mock.Anything, int64(2), mock.Anything).Return(nil)
+
+		resp, err := s.ReplayDeadLetters(ctx, &grpclibs.ReplayDeadLettersRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), resp.ReplayedCount)
+		assert.Equal(t, uint32(2), resp.FailedCount)
+		webhooks.AssertNotCalled(t, "RecordFailedReplay", mock.Anything, int64(3), mock.Anything)
+		webhooks.AssertExpectations(t)
+	})
+}
+
+func TestSetUserTier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		entitlements := new(repository.MockEntitlementRepository)
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), new(repository.MockWebhookRepository), entitlements, nil, testConfig)
+
+		entitlements.On("SetTier", mock.Anything, "user1", "premium").Return(nil)
+
+		_, err := s.SetUserTier(ctx, &grpclibs.SetUserTierRequest{UserId: "user1", Tier: "premium"})
+
+		require.NoError(t, err)
+		entitlements.AssertExpectations(t)
+	})
+
+	t.Run("TierTooLong", func(t *testing.T) {
+		entitlements := new(repository.MockEntitlementRepository)
+		s := NewExploreAdminGRPCServer(new(repository.MockDecisionRepository), new(repository.MockWebhookRepository), entitlements, nil, testConfig)
+
+		_, err := s.SetUserTier(ctx, &grpclibs.SetUserTierRequest{UserId: "user1", Tier: strings.Repeat("a", maxTierLength+1)})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		entitlements.AssertNotCalled(t, "SetTier")
+	})
+}
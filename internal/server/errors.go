@@ -0,0 +1,37 @@
+package server
+
+import (
+	"errors"
+	"log"
+
+	"github.com/shewitt93/explore_service/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failure logs why the action failed and returns the code and message to give the client.
+// Clients only learn what kind of failure it was, the driver's message stays in the logs.
+func failure(action string, err error) (codes.Code, string) {
+	log.Printf("failed to %s: %v", action, err)
+
+	switch {
+	case errors.Is(err, repository.ErrCanceled):
+		return codes.Canceled, "failed to " + action + ": request canceled"
+	case errors.Is(err, repository.ErrDeadlineExceeded):
+		return codes.DeadlineExceeded, "failed to " + action + ": timed out"
+	case errors.Is(err, repository.ErrUnavailable):
+		return codes.Unavailable, "failed to " + action + ": temporarily unavailable, try again later"
+	case errors.Is(err, repository.ErrConflict):
+		return codes.Aborted, "failed to " + action + ": conflicting update, try again"
+	case errors.Is(err, repository.ErrNotFound):
+		return codes.NotFound, "failed to " + action + ": not found"
+	default:
+		return codes.Internal, "failed to " + action
+	}
+}
+
+// failedTo returns the status error for a failed action
+func failedTo(action string, err error) error {
+	code, message := failure(action, err)
+	return status.Error(code, message)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/pubsub"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/pkg/grpclibs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFailedTo(t *testing.T) {
+	ctx := context.Background()
+	driverErr := &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"}
+
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"Conflict", &repository.Error{Kind: repository.ErrConflict, Err: driverErr}, codes.Aborted},
+		{"Unavailable", &repository.Error{Kind: repository.ErrUnavailable, Err: driverErr}, codes.Unavailable},
+		{"DeadlineExceeded", &repository.Error{Kind: repository.ErrDeadlineExceeded, Err: driverErr}, codes.DeadlineExceeded},
+		{"Canceled", &repository.Error{Kind: repository.ErrCanceled, Err: driverErr}, codes.Canceled},
+		{"Unknown", errors.New("failed to count likers: " + driverErr.Error()), codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := new(repository.MockDecisionRepository)
+			s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+			repo.On("CountLikersByRecipient", mock.Anything, "recipient1").Return(uint64(0), test.err)
+
+			_, err := s.CountLikedYou(ctx, &grpclibs.CountLikedYouRequest{RecipientUserId: "recipient1"})
+
+			require.Error(t, err)
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), "failed to count likers")
+			// The driver's message is only logged
+			assert.NotContains(t, status.Convert(err).Message(), "Deadlock")
+		})
+	}
+}
@@ -1,153 +1,1082 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/pubsub"
+	"github.com/shewitt93/explore_service/internal/quota"
 	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/validation"
 	"github.com/shewitt93/explore_service/pkg/grpclibs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"log"
+	"strings"
+	"time"
 )
 
+// maxIdempotencyKeyLength is the longest idempotency key that can be stored
+const maxIdempotencyKeyLength = 255
+
+// idempotencyClaimTTL is how long a request holds its idempotency key before storing a response.
+// It must outlast any request, as a retry takes over a key whose claim has expired and makes the decision again.
+const idempotencyClaimTTL = time.Minute
+
+// Config holds the tunable settings of the explore service
+type Config struct {
+	// DefaultPageSize is used when a list request doesn't specify a page size
+	DefaultPageSize uint32
+	// MaxPageSize is the largest page size a client is allowed to request
+	MaxPageSize uint32
+	// Cursors signs and verifies the pagination tokens handed to clients
+	Cursors *entity.CursorCodec
+	// MaxBatchSize is the most decisions a client can put in one PutDecisions call
+	MaxBatchSize uint32
+	// UndoWindow is how long after a decision is made that it can be undone
+	UndoWindow time.Duration
+	// IdempotencyTTL is how long the response to a request with an idempotency key is kept for replays
+	IdempotencyTTL time.Duration
+	// Validation is what valid user IDs look like
+	Validation validation.Rules
+}
+
 type ExploreGRPCServer struct {
 	grpclibs.ExploreServiceServer
-	repo repository.DecisionRepository
+	repo        repository.DecisionRepository
+	blocks      repository.BlockRepository
+	idempotency repository.IdempotencyRepository
+	// likes carries the events for WatchLikedYou, with each user's events published on their ID
+	likes *pubsub.Broker[entity.LikeEvent]
+	// quotas limits how many likes each user can make
+	quotas *quota.Limiter
+	// users checks that the users decisions are made about exist
+	users  repository.UserLookup
+	config Config
 }
 
-func NewExploreGRPCServer(repo repository.DecisionRepository) *ExploreGRPCServer {
+func NewExploreGRPCServer(repo repository.DecisionRepository, blocks repository.BlockRepository, idempotency repository.IdempotencyRepository, likes *pubsub.Broker[entity.LikeEvent], quotas *quota.Limiter, users repository.UserLookup, config Config) *ExploreGRPCServer {
 	return &ExploreGRPCServer{
-		repo: repo,
+		repo:        repo,
+		blocks:      blocks,
+		idempotency: idempotency,
+		likes:       likes,
+		quotas:      quotas,
+		users:       users,
+		config:      config,
 	}
 }
 
 func (s *ExploreGRPCServer) ListLikedYou(ctx context.Context, req *grpclibs.ListLikedYouRequest) (*grpclibs.ListLikedYouResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	filter := newLikerFilter(v, req)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
 
-	//user isn't empty check if receipient ID is not empty string
-	if req.GetRecipientUserId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "missing recipient user id")
+	// Tokens are only valid for the filter they were issued with, so every page stays within it
+	scope := entity.CursorScope{
+		List:   likerListScope(grpclibs.ExploreService_ListLikedYou_FullMethodName, filter),
+		UserID: req.GetRecipientUserId(),
 	}
-	var cursor *entity.Cursor
-	if req.PaginationToken != nil && *req.PaginationToken != "" {
-		decodedCursor, err := entity.DecodeCursor(*req.PaginationToken)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination token: %v", err)
-		}
-		cursor = decodedCursor
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
 	}
 
-	const pageSize = 50
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	likers, nextCursor, err := s.repo.ListLikersByRecipient(ctx, req.GetRecipientUserId(), cursor, pageSize)
+	page, err := s.repo.ListLikersByRecipient(ctx, req.GetRecipientUserId(), filter, cursor, pageSize)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch likers: %v", err)
+		return nil, failedTo("fetch likers", err)
 	}
 
-	response := &grpclibs.ListLikedYouResponse{
-		Likers: make([]*grpclibs.ListLikedYouResponse_Liker, 0, len(likers)),
+	return s.newListLikedYouResponse(page, scope)
+}
+
+func (s *ExploreGRPCServer) ListNewLikedYou(ctx context.Context, req *grpclibs.ListLikedYouRequest) (*grpclibs.ListLikedYouResponse, error) {
+	// Validate input, only listing likes within the requested time range
+	v := s.validate()
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	filter := newLikerFilter(v, req)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	for _, liker := range likers {
-		response.Likers = append(response.Likers, &grpclibs.ListLikedYouResponse_Liker{
-			ActorId:       liker.ActorID,
-			UnixTimestamp: liker.UnixTimestamp,
-		})
+	// Handle pagination token if provided, it's only valid for the filter it was issued with
+	scope := entity.CursorScope{
+		List:   likerListScope(grpclibs.ExploreService_ListNewLikedYou_FullMethodName, filter),
+		UserID: req.GetRecipientUserId(),
+	}
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the page size requested by the client
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call repository function to fetch new likers
+	page, err := s.repo.ListNewLikersByRecipient(ctx, req.GetRecipientUserId(), filter, cursor, pageSize)
+	if err != nil {
+		return nil, failedTo("fetch new likers", err)
+	}
+
+	// Build response
+	return s.newListLikedYouResponse(page, scope)
+}
+
+func (s *ExploreGRPCServer) CountLikedYou(ctx context.Context, req *grpclibs.CountLikedYouRequest) (*grpclibs.CountLikedYouResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountLikersByRecipient(ctx, req.GetRecipientUserId())
+	if err != nil {
+		return nil, failedTo("count likers", err)
+	}
+
+	return &grpclibs.CountLikedYouResponse{
+		Count: count,
+	}, nil
+}
+
+func (s *ExploreGRPCServer) GetLikeSummary(ctx context.Context, req *grpclibs.GetLikeSummaryRequest) (*grpclibs.GetLikeSummaryResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	if nextCursor != nil {
-		token, err := entity.EncodeCursor(nextCursor)
+	summary, err := s.repo.GetLikeSummary(ctx, req.GetRecipientUserId())
+	if err != nil {
+		return nil, failedTo("summarise likers", err)
+	}
+
+	return &grpclibs.GetLikeSummaryResponse{
+		TotalCount: summary.Total,
+		NewCount:   summary.New,
+		MatchCount: summary.Matches,
+	}, nil
+}
+
+func (s *ExploreGRPCServer) PutDecision(ctx context.Context, req *grpclibs.PutDecisionRequest) (*grpclibs.PutDecisionResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("actor_user_id", req.GetActorUserId())
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	v.NotSelf("recipient_user_id", req.GetRecipientUserId(), "actor_user_id", req.GetActorUserId())
+	decisionType, ok := resolveDecisionType(req.GetDecisionType(), req.GetLikedRecipient())
+	v.Check(ok, "decision_type", "is unknown")
+	v.Check(len(req.GetIdempotencyKey()) <= maxIdempotencyKeyLength, "idempotency_key", fmt.Sprintf("must be at most %d characters", maxIdempotencyKeyLength))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// A replayed request gets the original response without making the decision again
+	var requestHash []byte
+	var claimed bool
+	if req.GetIdempotencyKey() != "" {
+		var err error
+		requestHash, err = hashIdempotentRequest(req)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to encode pagination token: %v", err)
+			return nil, failedTo("hash request", err)
+		}
+
+		response, err := s.claimPutDecision(ctx, req, requestHash)
+		if err != nil || response != nil {
+			return response, err
 		}
-		response.NextPaginationToken = &token
+
+		// Give the key up if the decision isn't made, so the request can be retried with it
+		claimed = true
+		defer func() {
+			if claimed {
+				s.releasePutDecision(ctx, req)
+			}
+		}()
+	}
+
+	missing, err := s.users.FindMissingUsers(ctx, []string{req.GetActorUserId(), req.GetRecipientUserId()})
+	if err != nil {
+		return nil, failedTo("look up users", err)
+	}
+	if len(missing) > 0 {
+		return nil, status.Errorf(codes.NotFound, "users not found: %s", strings.Join(missing, ", "))
+	}
+
+	// Passes are never limited
+	if decisionType.Liked() {
+		quotaStatus, err := s.quotas.Check(ctx, req.GetActorUserId())
+		if err != nil {
+			return nil, failedTo("check like quota", err)
+		}
+		if quotaStatus.Exhausted() {
+			return nil, likeQuotaExceeded(quotaStatus)
+		}
+	}
+
+	// Call repository function to put decision
+	outcome, err := s.repo.CreateOrUpdateDecision(ctx, req.GetActorUserId(), req.GetRecipientUserId(), decisionType)
+	// A decision between blocked users is answered as if it didn't match, so a blocked user can't tell who blocked them,
+	// but nothing was written so nothing is published
+	blocked := errors.Is(err, repository.ErrBlocked)
+	if errors.Is(err, repository.ErrUnmatched) {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to put decision: %v", err)
+	}
+	if err != nil && !blocked {
+		return nil, failedTo("put decision", err)
+	}
+
+	// Return response with mutual like status and the match it formed or belongs to
+	response := &grpclibs.PutDecisionResponse{
+		MutualLikes: outcome.Matched,
+		NewMatch:    outcome.NewMatch,
+	}
+	if outcome.Matched {
+		response.MatchId = entity.MatchID(req.GetActorUserId(), req.GetRecipientUserId())
+		matchedAt := uint64(outcome.MatchedAt.Unix())
+		response.MatchedAt = &matchedAt
+	}
+	if outcome.PreviousType != nil {
+		previous := newDecisionType(*outcome.PreviousType)
+		response.PreviousDecisionType = &previous
+	}
+
+	if claimed {
+		s.storePutDecision(ctx, req, requestHash, response)
+		claimed = false
+	}
+
+	if !blocked {
+		s.publishDecision(req.GetActorUserId(), req.GetRecipientUserId(), decisionType, outcome)
 	}
 
 	return response, nil
 }
 
-func (s *ExploreGRPCServer) ListNewLikedYou(ctx context.Context, req *grpclibs.ListLikedYouRequest) (*grpclibs.ListLikedYouResponse, error) {
+// claimPutDecision claims the request's idempotency key, returning nil if the decision should be made.
+// Otherwise it returns the response stored for the key, or Aborted if the request that claimed it is still running.
+func (s *ExploreGRPCServer) claimPutDecision(ctx context.Context, req *grpclibs.PutDecisionRequest, requestHash []byte) (*grpclibs.PutDecisionResponse, error) {
+	stored, err := s.idempotency.ClaimKey(ctx, req.GetActorUserId(), req.GetIdempotencyKey(), requestHash, idempotencyClaimTTL)
+	if err != nil {
+		return nil, failedTo("claim idempotency key", err)
+	}
+	if stored == nil {
+		return nil, nil
+	}
 
-	// Check if recipient ID is not empty string
-	if req.GetRecipientUserId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "missing recipient user id")
+	if !bytes.Equal(stored.RequestHash, requestHash) {
+		return nil, validation.FieldError("idempotency_key", "was already used for a different request")
+	}
+	if stored.Pending {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress, retry it later")
 	}
 
-	// Handle pagination token if provided
-	var cursor *entity.Cursor
-	if req.PaginationToken != nil && *req.PaginationToken != "" {
-		decodedCursor, err := entity.DecodeCursor(*req.PaginationToken)
+	response := &grpclibs.PutDecisionResponse{}
+	if err := proto.Unmarshal(stored.Response, response); err != nil {
+		return nil, failedTo("decode stored response", err)
+	}
+	return response, nil
+}
+
+// releasePutDecision gives up the request's claim on its idempotency key. It runs once the request has failed,
+// so it isn't cut short by the request's context, and a failure is only logged as the claim expires anyway.
+func (s *ExploreGRPCServer) releasePutDecision(ctx context.Context, req *grpclibs.PutDecisionRequest) {
+	if err := s.idempotency.ReleaseKey(context.WithoutCancel(ctx), req.GetActorUserId(), req.GetIdempotencyKey()); err != nil {
+		log.Printf("failed to release idempotency key %q of user %s: %v", req.GetIdempotencyKey(), req.GetActorUserId(), err)
+	}
+}
+
+// storePutDecision keeps the response for replays of the request.
+// The decision has already been made, so failing to store it is only logged rather than failing the request.
+// Retries are then turned away until the claim expires, after which they make the decision again.
+// The response is stored even if the client has gone away, so its retry gets it.
+func (s *ExploreGRPCServer) storePutDecision(ctx context.Context, req *grpclibs.PutDecisionRequest, requestHash []byte, response *grpclibs.PutDecisionResponse) {
+	encoded, err := proto.Marshal(response)
+	if err == nil {
+		stored := entity.StoredResponse{RequestHash: requestHash, Response: encoded}
+		err = s.idempotency.PutResponse(context.WithoutCancel(ctx), req.GetActorUserId(), req.GetIdempotencyKey(), stored, s.config.IdempotencyTTL)
+	}
+	if err != nil {
+		log.Printf("failed to store response for idempotency key %q of user %s: %v", req.GetIdempotencyKey(), req.GetActorUserId(), err)
+	}
+}
+
+// hashIdempotentRequest returns the hash identifying the request, ignoring its idempotency key
+func hashIdempotentRequest(req *grpclibs.PutDecisionRequest) ([]byte, error) {
+	unkeyed := proto.Clone(req).(*grpclibs.PutDecisionRequest)
+	unkeyed.IdempotencyKey = ""
+
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(unkeyed)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(encoded)
+	return hash[:], nil
+}
+
+func (s *ExploreGRPCServer) PutDecisions(ctx context.Context, req *grpclibs.PutDecisionsRequest) (*grpclibs.PutDecisionsResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("actor_user_id", req.GetActorUserId())
+	v.Check(len(req.GetDecisions()) > 0, "decisions", "is required")
+	v.Check(len(req.GetDecisions()) <= int(s.config.MaxBatchSize), "decisions", fmt.Sprintf("must have at most %d decisions", s.config.MaxBatchSize))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Reject invalid decisions individually so they don't fail the rest of the batch
+	results := make([]*grpclibs.PutDecisionsResponse_Result, len(req.GetDecisions()))
+	pending := make([]entity.PendingDecision, 0, len(req.GetDecisions()))
+	pendingIndexes := make([]int, 0, len(req.GetDecisions()))
+	seen := make(map[string]bool, len(req.GetDecisions()))
+	for i, decision := range req.GetDecisions() {
+		results[i] = &grpclibs.PutDecisionsResponse_Result{RecipientUserId: decision.GetRecipientUserId()}
+		decisionType, ok := resolveDecisionType(decision.GetDecisionType(), decision.GetLikedRecipient())
+
+		switch violation := s.config.Validation.UserIDViolation(decision.GetRecipientUserId()); {
+		case violation != "":
+			results[i].Error = newPutDecisionsError(codes.InvalidArgument, "recipient_user_id "+violation)
+		case decision.GetRecipientUserId() == req.GetActorUserId():
+			results[i].Error = newPutDecisionsError(codes.InvalidArgument, "recipient_user_id must differ from actor_user_id")
+		case seen[decision.GetRecipientUserId()]:
+			results[i].Error = newPutDecisionsError(codes.InvalidArgument, "recipient_user_id is duplicated")
+		case !ok:
+			results[i].Error = newPutDecisionsError(codes.InvalidArgument, "decision_type is unknown")
+		default:
+			seen[decision.GetRecipientUserId()] = true
+			pending = append(pending, entity.PendingDecision{
+				RecipientID: decision.GetRecipientUserId(),
+				Type:        decisionType,
+			})
+			pendingIndexes = append(pendingIndexes, i)
+		}
+	}
+
+	pending, pendingIndexes, err := s.dropMissingRecipients(ctx, req.GetActorUserId(), pending, pendingIndexes, results)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, pendingIndexes, err = s.limitBatchLikes(ctx, req.GetActorUserId(), pending, pendingIndexes, results)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) > 0 {
+		// Call repository function to put the decisions
+		decisionResults, err := s.repo.CreateOrUpdateDecisions(ctx, req.GetActorUserId(), pending)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination token: %v", err)
+			return nil, failedTo("put decisions", err)
+		}
+
+		for i, result := range decisionResults {
+			if errors.Is(result.Err, repository.ErrBlocked) {
+				// Answered as if it didn't match, as in PutDecision
+				continue
+			}
+			if errors.Is(result.Err, repository.ErrUnmatched) {
+				results[pendingIndexes[i]].Error = newPutDecisionsError(codes.FailedPrecondition, fmt.Sprintf("failed to put decision: %v", result.Err))
+				continue
+			}
+			if result.Err != nil {
+				results[pendingIndexes[i]].Error = newPutDecisionsError(failure("put decision", result.Err))
+				continue
+			}
+			results[pendingIndexes[i]].MutualLikes = result.Outcome.Matched
+			s.publishDecision(req.GetActorUserId(), result.RecipientID, pending[i].Type, result.Outcome)
 		}
-		cursor = decodedCursor
 	}
 
-	// Define page size
-	const pageSize = 50
+	return &grpclibs.PutDecisionsResponse{
+		Results: results,
+	}, nil
+}
 
-	// Call repository function to fetch new likers
-	likers, nextCursor, err := s.repo.ListNewLikersByRecipient(ctx, req.GetRecipientUserId(), cursor, pageSize)
+// dropMissingRecipients rejects the decisions of a batch about users that don't exist,
+// returning the decisions that can still be put. The whole batch fails if the actor doesn't exist.
+func (s *ExploreGRPCServer) dropMissingRecipients(ctx context.Context, actorID string, pending []entity.PendingDecision, pendingIndexes []int, results []*grpclibs.PutDecisionsResponse_Result) ([]entity.PendingDecision, []int, error) {
+	userIDs := make([]string, 0, len(pending)+1)
+	userIDs = append(userIDs, actorID)
+	for _, decision := range pending {
+		userIDs = append(userIDs, decision.RecipientID)
+	}
+
+	missing, err := s.users.FindMissingUsers(ctx, userIDs)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch new likers: %v", err)
+		return nil, nil, failedTo("look up users", err)
+	}
+	if len(missing) == 0 {
+		return pending, pendingIndexes, nil
 	}
 
-	// Build response
-	response := &grpclibs.ListLikedYouResponse{
-		Likers: make([]*grpclibs.ListLikedYouResponse_Liker, 0, len(likers)),
+	isMissing := make(map[string]bool, len(missing))
+	for _, userID := range missing {
+		isMissing[userID] = true
+	}
+	if isMissing[actorID] {
+		return nil, nil, status.Errorf(codes.NotFound, "users not found: %s", actorID)
 	}
 
-	// Add likers to response
-	for _, liker := range likers {
-		response.Likers = append(response.Likers, &grpclibs.ListLikedYouResponse_Liker{
-			ActorId:       liker.ActorID,
-			UnixTimestamp: liker.UnixTimestamp,
-		})
+	found := make([]entity.PendingDecision, 0, len(pending))
+	foundIndexes := make([]int, 0, len(pending))
+	for i, decision := range pending {
+		if isMissing[decision.RecipientID] {
+			results[pendingIndexes[i]].Error = newPutDecisionsError(codes.NotFound, "recipient user not found")
+			continue
+		}
+		found = append(found, decision)
+		foundIndexes = append(foundIndexes, pendingIndexes[i])
 	}
 
-	// Add pagination token if there are more results
-	if nextCursor != nil {
-		token, err := entity.EncodeCursor(nextCursor)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to encode pagination token: %v", err)
+	return found, foundIndexes, nil
+}
+
+// limitBatchLikes rejects the likes of a batch beyond the actor's remaining quota, in the order they were given,
+// returning the decisions that can still be put
+func (s *ExploreGRPCServer) limitBatchLikes(ctx context.Context, actorID string, pending []entity.PendingDecision, pendingIndexes []int, results []*grpclibs.PutDecisionsResponse_Result) ([]entity.PendingDecision, []int, error) {
+	likes := 0
+	for _, decision := range pending {
+		if decision.Type.Liked() {
+			likes++
+		}
+	}
+	if likes == 0 {
+		return pending, pendingIndexes, nil
+	}
+
+	quotaStatus, err := s.quotas.Check(ctx, actorID)
+	if err != nil {
+		return nil, nil, failedTo("check like quota", err)
+	}
+	if quotaStatus.Unlimited || likes <= int(quotaStatus.Remaining) {
+		return pending, pendingIndexes, nil
+	}
+
+	allowed := quotaStatus.Remaining
+	limited := make([]entity.PendingDecision, 0, len(pending))
+	limitedIndexes := make([]int, 0, len(pending))
+	for i, decision := range pending {
+		if decision.Type.Liked() {
+			if allowed == 0 {
+				message := fmt.Sprintf("like quota exceeded until %s", quotaStatus.ResetAt.UTC().Format(time.RFC3339))
+				results[pendingIndexes[i]].Error = newPutDecisionsError(codes.ResourceExhausted, message)
+				continue
+			}
+			allowed--
+		}
+		limited = append(limited, decision)
+		limitedIndexes = append(limitedIndexes, pendingIndexes[i])
+	}
+
+	return limited, limitedIndexes, nil
+}
+
+// likeQuotaExceeded returns the error for a user who has run out of likes, with a RetryInfo detail saying when one frees up
+func likeQuotaExceeded(quotaStatus quota.Status) error {
+	st := status.Newf(codes.ResourceExhausted, "like quota of %d exceeded", quotaStatus.Limit)
+
+	retryDelay := max(time.Until(quotaStatus.ResetAt), 0)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (s *ExploreGRPCServer) GetQuota(ctx context.Context, req *grpclibs.GetQuotaRequest) (*grpclibs.GetQuotaResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("user_id", req.GetUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	quotaStatus, err := s.quotas.Check(ctx, req.GetUserId())
+	if err != nil {
+		return nil, failedTo("check like quota", err)
+	}
+
+	response := &grpclibs.GetQuotaResponse{
+		Unlimited:     quotaStatus.Unlimited,
+		WindowSeconds: uint64(s.quotas.Window().Seconds()),
+	}
+	if !quotaStatus.Unlimited {
+		response.Limit = quotaStatus.Limit
+		response.Remaining = quotaStatus.Remaining
+		if !quotaStatus.ResetAt.IsZero() {
+			resetsAt := uint64(quotaStatus.ResetAt.Unix())
+			response.ResetsAt = &resetsAt
 		}
-		response.NextPaginationToken = &token
 	}
 
 	return response, nil
 }
 
-func (s *ExploreGRPCServer) CountLikedYou(ctx context.Context, req *grpclibs.CountLikedYouRequest) (*grpclibs.CountLikedYouResponse, error) {
+// publishDecision tells users watching for likes about a recorded decision: the recipient hears about a like,
+// and both users hear about a match it formed
+func (s *ExploreGRPCServer) publishDecision(actorID string, recipientID string, decisionType entity.DecisionType, outcome entity.DecisionOutcome) {
+	if !decisionType.Liked() {
+		return
+	}
+
+	s.likes.Publish(recipientID, entity.LikeEvent{Liker: &entity.Liker{
+		ActorID:       actorID,
+		UnixTimestamp: uint64(outcome.DecidedAt.Unix()),
+		SuperLike:     decisionType == entity.DecisionTypeSuperLike,
+	}})
 
-	if req.GetRecipientUserId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "missing recipient user id")
+	if outcome.NewMatch {
+		matchedAt := uint64(outcome.MatchedAt.Unix())
+		s.likes.Publish(recipientID, entity.LikeEvent{Match: &entity.Match{UserID: actorID, UnixTimestamp: matchedAt}})
+		s.likes.Publish(actorID, entity.LikeEvent{Match: &entity.Match{UserID: recipientID, UnixTimestamp: matchedAt}})
 	}
+}
 
-	count, err := s.repo.CountLikersByRecipient(ctx, req.GetRecipientUserId())
+func (s *ExploreGRPCServer) WatchLikedYou(req *grpclibs.WatchLikedYouRequest, stream grpclibs.ExploreService_WatchLikedYouServer) error {
+	// Validate input
+	v := s.validate()
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	// Stop receiving events as soon as the stream ends
+	subscription := s.likes.Subscribe(req.GetRecipientUserId())
+	defer subscription.Unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case event, ok := <-subscription.Messages():
+			if !ok {
+				// The client wasn't keeping up and missed events, it has to catch up with ListLikedYou
+				return status.Errorf(codes.ResourceExhausted, "stream fell behind, reconnect and catch up with ListLikedYou")
+			}
+			if err := stream.Send(newWatchLikedYouResponse(req.GetRecipientUserId(), event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// newWatchLikedYouResponse converts an event for the recipient into a response
+func newWatchLikedYouResponse(recipientID string, event entity.LikeEvent) *grpclibs.WatchLikedYouResponse {
+	if event.Match != nil {
+		return &grpclibs.WatchLikedYouResponse{
+			Event: &grpclibs.WatchLikedYouResponse_Match_{Match: &grpclibs.WatchLikedYouResponse_Match{
+				UserId:        event.Match.UserID,
+				MatchId:       entity.MatchID(recipientID, event.Match.UserID),
+				UnixTimestamp: event.Match.UnixTimestamp,
+			}},
+		}
+	}
+
+	return &grpclibs.WatchLikedYouResponse{
+		Event: &grpclibs.WatchLikedYouResponse_Liker{Liker: &grpclibs.ListLikedYouResponse_Liker{
+			ActorId:       event.Liker.ActorID,
+			UnixTimestamp: event.Liker.UnixTimestamp,
+			SuperLiked:    event.Liker.SuperLike,
+		}},
+	}
+}
+
+// validate returns a validator for a request, following the configured rules
+func (s *ExploreGRPCServer) validate() *validation.Validator {
+	return validation.New(s.config.Validation)
+}
+
+// resolveDecisionType returns the decision type requested, falling back to the deprecated liked flag
+// for clients that don't set one. It reports false for decision types it doesn't know.
+func resolveDecisionType(decisionType grpclibs.DecisionType, liked bool) (entity.DecisionType, bool) {
+	switch decisionType {
+	case grpclibs.DecisionType_DECISION_TYPE_UNSPECIFIED:
+		if liked {
+			return entity.DecisionTypeLike, true
+		}
+		return entity.DecisionTypePass, true
+	case grpclibs.DecisionType_DECISION_TYPE_PASS:
+		return entity.DecisionTypePass, true
+	case grpclibs.DecisionType_DECISION_TYPE_LIKE:
+		return entity.DecisionTypeLike, true
+	case grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE:
+		return entity.DecisionTypeSuperLike, true
+	default:
+		return 0, false
+	}
+}
+
+// newDecisionType converts a decision type into its protobuf form
+func newDecisionType(decisionType entity.DecisionType) grpclibs.DecisionType {
+	switch decisionType {
+	case entity.DecisionTypeLike:
+		return grpclibs.DecisionType_DECISION_TYPE_LIKE
+	case entity.DecisionTypeSuperLike:
+		return grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE
+	default:
+		return grpclibs.DecisionType_DECISION_TYPE_PASS
+	}
+}
+
+// newPutDecisionsError returns the error reported for a single decision of a batch
+func newPutDecisionsError(code codes.Code, message string) *grpclibs.PutDecisionsResponse_Error {
+	return &grpclibs.PutDecisionsResponse_Error{
+		Code:    uint32(code),
+		Message: message,
+	}
+}
+
+func (s *ExploreGRPCServer) UndoDecision(ctx context.Context, req *grpclibs.UndoDecisionRequest) (*grpclibs.UndoDecisionResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("actor_user_id", req.GetActorUserId())
+	v.UserID("recipient_user_id", req.GetRecipientUserId())
+	v.NotSelf("recipient_user_id", req.GetRecipientUserId(), "actor_user_id", req.GetActorUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.UndoDecision(ctx, req.GetActorUserId(), req.GetRecipientUserId(), s.config.UndoWindow)
+	switch {
+	case errors.Is(err, repository.ErrDecisionNotFound):
+		return nil, status.Errorf(codes.NotFound, "no decision to undo")
+	case errors.Is(err, repository.ErrUndoWindowExpired), errors.Is(err, repository.ErrNothingToUndo), errors.Is(err, repository.ErrUnmatched):
+		return nil, status.Errorf(codes.FailedPrecondition, "decision can't be undone: %v", err)
+	case errors.Is(err, repository.ErrBlocked):
+		// Reported like any other decision that can't be undone, so a blocked user can't tell who blocked them
+		return nil, status.Errorf(codes.FailedPrecondition, "decision can't be undone: %v", repository.ErrNothingToUndo)
+	case err != nil:
+		return nil, failedTo("undo decision", err)
+	}
+
+	response := &grpclibs.UndoDecisionResponse{
+		MatchDissolved: result.MatchDissolved,
+	}
+	if result.Restored != nil {
+		liked := result.Restored.Liked()
+		restored := newDecisionType(*result.Restored)
+		response.LikedRecipient = &liked
+		response.DecisionType = &restored
+	}
+
+	return response, nil
+}
+
+func (s *ExploreGRPCServer) GetRelationship(ctx context.Context, req *grpclibs.GetRelationshipRequest) (*grpclibs.GetRelationshipResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("user_a_id", req.GetUserAId())
+	v.UserID("user_b_id", req.GetUserBId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	relationships, err := s.repo.GetRelationships(ctx, req.GetUserAId(), []string{req.GetUserBId()})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to count likers: %v", err)
+		return nil, failedTo("get relationship", err)
 	}
 
-	return &grpclibs.CountLikedYouResponse{
-		Count: count,
+	return &grpclibs.GetRelationshipResponse{
+		Relationship: newRelationship(relationships[0]),
 	}, nil
 }
 
-func (s *ExploreGRPCServer) PutDecision(ctx context.Context, req *grpclibs.PutDecisionRequest) (*grpclibs.PutDecisionResponse, error) {
+func (s *ExploreGRPCServer) GetRelationships(ctx context.Context, req *grpclibs.GetRelationshipsRequest) (*grpclibs.GetRelationshipsResponse, error) {
 	// Validate input
-	if req.GetActorUserId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "missing actor user id")
+	v := s.validate()
+	v.UserID("user_a_id", req.GetUserAId())
+	v.Check(len(req.GetUserBIds()) > 0, "user_b_ids", "is required")
+	v.Check(len(req.GetUserBIds()) <= int(s.config.MaxBatchSize), "user_b_ids", fmt.Sprintf("must have at most %d ids", s.config.MaxBatchSize))
+	for i, id := range req.GetUserBIds() {
+		v.UserID(fmt.Sprintf("user_b_ids[%d]", i), id)
 	}
-	if req.GetRecipientUserId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "missing recipient user id")
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	// Call repository function to put decision
-	mutualLike, err := s.repo.CreateOrUpdateDecision(ctx, req.GetActorUserId(), req.GetRecipientUserId(), req.GetLikedRecipient())
+	relationships, err := s.repo.GetRelationships(ctx, req.GetUserAId(), req.GetUserBIds())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to put decision: %v", err)
+		return nil, failedTo("get relationships", err)
+	}
+
+	response := &grpclibs.GetRelationshipsResponse{
+		Relationships: make([]*grpclibs.Relationship, 0, len(relationships)),
+	}
+
+	for _, relationship := range relationships {
+		response.Relationships = append(response.Relationships, newRelationship(relationship))
+	}
+
+	return response, nil
+}
+
+// newRelationship converts a relationship into its protobuf form
+func newRelationship(relationship entity.Relationship) *grpclibs.Relationship {
+	return &grpclibs.Relationship{
+		UserAId:   relationship.UserAID,
+		UserBId:   relationship.UserBID,
+		ADecision: newRelationshipDecision(relationship.AToB),
+		BDecision: newRelationshipDecision(relationship.BToA),
+		Matched:   relationship.Matched(),
 	}
+}
+
+// newRelationshipDecision converts one side of a relationship into its protobuf form
+func newRelationshipDecision(decision *entity.Decision) *grpclibs.Relationship_Decision {
+	if decision == nil {
+		return &grpclibs.Relationship_Decision{State: grpclibs.DecisionState_DECISION_STATE_NONE}
+	}
+
+	state := grpclibs.DecisionState_DECISION_STATE_PASSED
+	if decision.Liked() {
+		state = grpclibs.DecisionState_DECISION_STATE_LIKED
+	}
+
+	unixTimestamp := uint64(decision.UpdatedAt.Unix())
+	return &grpclibs.Relationship_Decision{
+		State:         state,
+		UnixTimestamp: &unixTimestamp,
+		DecisionType:  newDecisionType(decision.Type),
+	}
+}
+
+func (s *ExploreGRPCServer) ListMatches(ctx context.Context, req *grpclibs.ListMatchesRequest) (*grpclibs.ListMatchesResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("user_id", req.GetUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Handle pagination token if provided
+	scope := entity.CursorScope{
+		List:   grpclibs.ExploreService_ListMatches_FullMethodName,
+		UserID: req.GetUserId(),
+	}
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.repo.ListMatchesByUser(ctx, req.GetUserId(), cursor, pageSize)
+	if err != nil {
+		return nil, failedTo("fetch matches", err)
+	}
+
+	response := &grpclibs.ListMatchesResponse{
+		Matches: make([]*grpclibs.ListMatchesResponse_Match, 0, len(page.Items)),
+	}
+
+	for _, match := range page.Items {
+		response.Matches = append(response.Matches, &grpclibs.ListMatchesResponse_Match{
+			UserId:        match.UserID,
+			UnixTimestamp: match.UnixTimestamp,
+		})
+	}
+
+	if response.NextPaginationToken, err = s.config.encodePaginationToken(page.NextCursor, scope); err != nil {
+		return nil, err
+	}
+	if response.PreviousPaginationToken, err = s.config.encodePaginationToken(page.PreviousCursor, scope); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *ExploreGRPCServer) ListMyDecisions(ctx context.Context, req *grpclibs.ListMyDecisionsRequest) (*grpclibs.ListMyDecisionsResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("actor_user_id", req.GetActorUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	var filter entity.DecisionFilter
+	switch req.GetFilter() {
+	case grpclibs.DecisionFilter_DECISION_FILTER_ALL:
+		filter = entity.DecisionFilterAll
+	case grpclibs.DecisionFilter_DECISION_FILTER_LIKED:
+		filter = entity.DecisionFilterLiked
+	case grpclibs.DecisionFilter_DECISION_FILTER_PASSED:
+		filter = entity.DecisionFilterPassed
+	default:
+		return nil, validation.FieldError("filter", "is unknown")
+	}
+
+	// Handle pagination token if provided, tokens are only valid for the filter they were issued with
+	scope := entity.CursorScope{
+		List:   grpclibs.ExploreService_ListMyDecisions_FullMethodName + "?" + req.GetFilter().String(),
+		UserID: req.GetActorUserId(),
+	}
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.repo.ListDecisionsByActor(ctx, req.GetActorUserId(), filter, cursor, pageSize)
+	if err != nil {
+		return nil, failedTo("fetch decisions", err)
+	}
+
+	response := &grpclibs.ListMyDecisionsResponse{
+		Decisions: make([]*grpclibs.ListMyDecisionsResponse_Decision, 0, len(page.Items)),
+	}
+
+	for _, decision := range page.Items {
+		response.Decisions = append(response.Decisions, &grpclibs.ListMyDecisionsResponse_Decision{
+			RecipientUserId: decision.RecipientID,
+			LikedRecipient:  decision.Liked(),
+			UnixTimestamp:   uint64(decision.UpdatedAt.Unix()),
+			DecisionType:    newDecisionType(decision.Type),
+		})
+	}
+
+	if response.NextPaginationToken, err = s.config.encodePaginationToken(page.NextCursor, scope); err != nil {
+		return nil, err
+	}
+	if response.PreviousPaginationToken, err = s.config.encodePaginationToken(page.PreviousCursor, scope); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
 
-	// Return response with mutual like status
-	return &grpclibs.PutDecisionResponse{
-		MutualLikes: mutualLike,
+func (s *ExploreGRPCServer) Block(ctx context.Context, req *grpclibs.BlockRequest) (*grpclibs.BlockResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("blocker_user_id", req.GetBlockerUserId())
+	v.UserID("blocked_user_id", req.GetBlockedUserId())
+	v.NotSelf("blocked_user_id", req.GetBlockedUserId(), "blocker_user_id", req.GetBlockerUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	matchDissolved, err := s.blocks.Block(ctx, req.GetBlockerUserId(), req.GetBlockedUserId())
+	if err != nil {
+		return nil, failedTo("block user", err)
+	}
+
+	return &grpclibs.BlockResponse{
+		MatchDissolved: matchDissolved,
 	}, nil
 }
+
+func (s *ExploreGRPCServer) Unblock(ctx context.Context, req *grpclibs.UnblockRequest) (*grpclibs.UnblockResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("blocker_user_id", req.GetBlockerUserId())
+	v.UserID("blocked_user_id", req.GetBlockedUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.blocks.Unblock(ctx, req.GetBlockerUserId(), req.GetBlockedUserId()); err != nil {
+		return nil, failedTo("unblock user", err)
+	}
+
+	return &grpclibs.UnblockResponse{}, nil
+}
+
+func (s *ExploreGRPCServer) ListBlocked(ctx context.Context, req *grpclibs.ListBlockedRequest) (*grpclibs.ListBlockedResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("blocker_user_id", req.GetBlockerUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Handle pagination token if provided
+	scope := entity.CursorScope{
+		List:   grpclibs.ExploreService_ListBlocked_FullMethodName,
+		UserID: req.GetBlockerUserId(),
+	}
+	cursor, err := s.config.decodePaginationToken(req.PaginationToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := s.config.resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.blocks.ListBlockedByUser(ctx, req.GetBlockerUserId(), cursor, pageSize)
+	if err != nil {
+		return nil, failedTo("fetch blocked users", err)
+	}
+
+	response := &grpclibs.ListBlockedResponse{
+		BlockedUsers: make([]*grpclibs.ListBlockedResponse_BlockedUser, 0, len(page.Items)),
+	}
+
+	for _, block := range page.Items {
+		response.BlockedUsers = append(response.BlockedUsers, &grpclibs.ListBlockedResponse_BlockedUser{
+			UserId:        block.BlockedID,
+			UnixTimestamp: uint64(block.CreatedAt.Unix()),
+		})
+	}
+
+	if response.NextPaginationToken, err = s.config.encodePaginationToken(page.NextCursor, scope); err != nil {
+		return nil, err
+	}
+	if response.PreviousPaginationToken, err = s.config.encodePaginationToken(page.PreviousCursor, scope); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *ExploreGRPCServer) Unmatch(ctx context.Context, req *grpclibs.UnmatchRequest) (*grpclibs.UnmatchResponse, error) {
+	// Validate input
+	v := s.validate()
+	v.UserID("user_id", req.GetUserId())
+	v.UserID("matched_user_id", req.GetMatchedUserId())
+	v.NotSelf("matched_user_id", req.GetMatchedUserId(), "user_id", req.GetUserId())
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	err := s.repo.Unmatch(ctx, req.GetUserId(), req.GetMatchedUserId())
+	if errors.Is(err, repository.ErrNotMatched) {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to unmatch: %v", err)
+	}
+	if err != nil {
+		return nil, failedTo("unmatch", err)
+	}
+
+	return &grpclibs.UnmatchResponse{}, nil
+}
+
+// resolvePageSize returns the requested page size, or the configured default if none was requested
+func (c Config) resolvePageSize(requested *uint32) (int, error) {
+	if requested == nil {
+		return int(c.DefaultPageSize), nil
+	}
+
+	if *requested == 0 || *requested > c.MaxPageSize {
+		return 0, validation.FieldError("page_size", fmt.Sprintf("must be between 1 and %d", c.MaxPageSize))
+	}
+
+	return int(*requested), nil
+}
+
+// newLikerFilter returns the filter of likers requested by the client, adding any invalid fields to the validator
+func newLikerFilter(v *validation.Validator, req *grpclibs.ListLikedYouRequest) entity.LikerFilter {
+	v.UnixTimestamp("since", req.Since)
+	v.UnixTimestamp("until", req.Until)
+	v.Check(req.Since == nil || req.Until == nil || req.GetSince() < req.GetUntil(), "since", "must be before until")
+
+	filter := entity.LikerFilter{Unanswered: req.GetUnanswered()}
+	if req.Since != nil {
+		since := time.Unix(int64(req.GetSince()), 0)
+		filter.Since = &since
+	}
+	if req.Until != nil {
+		until := time.Unix(int64(req.GetUntil()), 0)
+		filter.Until = &until
+	}
+	return filter
+}
+
+// likerListScope returns the pagination token scope of the list of likers with the filter.
+// A list without a filter keeps the plain method name, so tokens issued before filters existed still work.
+func likerListScope(method string, filter entity.LikerFilter) string {
+	var params []string
+	if filter.Since != nil {
+		params = append(params, fmt.Sprintf("since=%d", filter.Since.Unix()))
+	}
+	if filter.Until != nil {
+		params = append(params, fmt.Sprintf("until=%d", filter.Until.Unix()))
+	}
+	if filter.Unanswered {
+		params = append(params, "unanswered")
+	}
+
+	if len(params) == 0 {
+		return method
+	}
+	return method + "?" + strings.Join(params, "&")
+}
+
+// newListLikedYouResponse converts a page of likers into a response, with pagination tokens for the pages either side
+func (s *ExploreGRPCServer) newListLikedYouResponse(page *entity.Page[entity.Liker], scope entity.CursorScope) (*grpclibs.ListLikedYouResponse, error) {
+	response := &grpclibs.ListLikedYouResponse{
+		Likers: make([]*grpclibs.ListLikedYouResponse_Liker, 0, len(page.Items)),
+	}
+
+	for _, liker := range page.Items {
+		response.Likers = append(response.Likers, &grpclibs.ListLikedYouResponse_Liker{
+			ActorId:       liker.ActorID,
+			UnixTimestamp: liker.UnixTimestamp,
+			SuperLiked:    liker.SuperLike,
+		})
+	}
+
+	var err error
+	if response.NextPaginationToken, err = s.config.encodePaginationToken(page.NextCursor, scope); err != nil {
+		return nil, err
+	}
+	if response.PreviousPaginationToken, err = s.config.encodePaginationToken(page.PreviousCursor, scope); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// decodePaginationToken verifies the token against the scope it is being used in, returning a nil cursor if there's no token
+func (c Config) decodePaginationToken(token *string, scope entity.CursorScope) (*entity.Cursor, error) {
+	if token == nil || *token == "" {
+		return nil, nil
+	}
+
+	cursor, err := c.Cursors.DecodeCursor(*token, scope)
+	if err != nil {
+		return nil, validation.FieldError("pagination_token", fmt.Sprintf("is invalid: %v", err))
+	}
+	return cursor, nil
+}
+
+// encodePaginationToken signs the cursor for the scope, returning nil if there's no cursor
+func (c Config) encodePaginationToken(cursor *entity.Cursor, scope entity.CursorScope) (*string, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	token, err := c.Cursors.EncodeCursor(cursor, scope)
+	if err != nil {
+		return nil, failedTo("encode pagination token", err)
+	}
+	return &token, nil
+}
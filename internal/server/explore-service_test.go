@@ -0,0 +1,871 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/pubsub"
+	"github.com/shewitt93/explore_service/internal/quota"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/shewitt93/explore_service/internal/validation"
+	"github.com/shewitt93/explore_service/pkg/grpclibs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var testConfig = Config{
+	DefaultPageSize: 50,
+	MaxPageSize:     500,
+	Cursors:         mustCursorCodec(),
+	MaxBatchSize:    500,
+	IdempotencyTTL:  time.Hour,
+	Validation:      validation.Rules{IDFormat: validation.IDFormatAny, MaxIDLength: validation.MaxIDLength},
+}
+
+// unlimitedQuotas lets every user like as often as they want
+var unlimitedQuotas = quota.NewLimiter(nil, quota.FixedTier(quota.TierFree), nil, 24*time.Hour)
+
+func mustCursorCodec() *entity.CursorCodec {
+	codec, err := entity.NewCursorCodec([]entity.CursorKey{{ID: "test", Secret: bytes.Repeat([]byte{1}, 32)}}, time.Hour)
+	if err != nil {
+		panic(err)
+	}
+	return codec
+}
+
+func uint32Ptr(v uint32) *uint32 {
+	return &v
+}
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func TestListLikedYou_PageSize(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DefaultPageSize", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{}, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Liker]{Items: []entity.Liker{{ActorID: "actor1", UnixTimestamp: 1738754100}}}, nil)
+
+		resp, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{RecipientUserId: "recipient1"})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Likers, 1)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("RequestedPageSize", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListNewLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{}, (*entity.Cursor)(nil), 20).
+			Return(&entity.Page[entity.Liker]{}, nil)
+
+		_, err := s.ListNewLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			PageSize:        uint32Ptr(20),
+		})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		for _, pageSize := range []uint32{0, 501} {
+			repo := new(repository.MockDecisionRepository)
+			s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+			_, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+				RecipientUserId: "recipient1",
+				PageSize:        uint32Ptr(pageSize),
+			})
+
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			repo.AssertNotCalled(t, "ListLikersByRecipient")
+		}
+	})
+}
+
+func TestListLikedYou_TimeRange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		since := time.Unix(1736121600, 0)
+		until := time.Unix(1736726400, 0)
+		repo.On("ListNewLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{Since: &since, Until: &until}, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Liker]{}, nil)
+
+		_, err := s.ListNewLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			Since:           uint64Ptr(1736121600),
+			Until:           uint64Ptr(1736726400),
+		})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Unanswered", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{Unanswered: true}, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Liker]{}, nil)
+
+		_, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{RecipientUserId: "recipient1", Unanswered: true})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("SinceNotBeforeUntil", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		_, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			Since:           uint64Ptr(1736726400),
+			Until:           uint64Ptr(1736726400),
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "ListLikersByRecipient")
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		_, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			Until:           uint64Ptr(1 << 63),
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "ListLikersByRecipient")
+	})
+
+	t.Run("TokenFromAnotherRange", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		since := time.Unix(1736121600, 0)
+		cursor := &entity.Cursor{UpdatedAt: time.Unix(1736300000, 0).UTC(), ActorId: "actor5"}
+		repo.On("ListLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{Since: &since}, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Liker]{NextCursor: cursor}, nil)
+
+		resp, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{RecipientUserId: "recipient1", Since: uint64Ptr(1736121600)})
+		require.NoError(t, err)
+		require.NotNil(t, resp.NextPaginationToken)
+
+		// The token can't be carried over to a list of likes since another time
+		_, err = s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			Since:           uint64Ptr(1736000000),
+			PaginationToken: resp.NextPaginationToken,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNumberOfCalls(t, "ListLikersByRecipient", 1)
+	})
+}
+
+func TestListLikedYou_PaginationToken(t *testing.T) {
+	ctx := context.Background()
+	cursor := &entity.Cursor{UpdatedAt: time.Unix(1738300000, 0).UTC(), ActorId: "actor5"}
+
+	t.Run("IssuedForAnotherRecipient", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		token, err := testConfig.Cursors.EncodeCursor(cursor, entity.CursorScope{
+			List:   grpclibs.ExploreService_ListLikedYou_FullMethodName,
+			UserID: "recipient2",
+		})
+		require.NoError(t, err)
+
+		_, err = s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			PaginationToken: &token,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "ListLikersByRecipient")
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{}, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Liker]{NextCursor: cursor}, nil)
+		repo.On("ListLikersByRecipient", mock.Anything, "recipient1", entity.LikerFilter{}, cursor, 50).
+			Return(&entity.Page[entity.Liker]{}, nil)
+
+		resp, err := s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{RecipientUserId: "recipient1"})
+		require.NoError(t, err)
+		require.NotNil(t, resp.NextPaginationToken)
+
+		_, err = s.ListLikedYou(ctx, &grpclibs.ListLikedYouRequest{
+			RecipientUserId: "recipient1",
+			PaginationToken: resp.NextPaginationToken,
+		})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestPutDecision_DecisionType(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SuperLike", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		pass := entity.DecisionTypePass
+		matchedAt := time.Unix(1736121600, 0)
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypeSuperLike).
+			Return(entity.DecisionOutcome{Matched: true, NewMatch: true, MatchedAt: matchedAt, PreviousType: &pass}, nil)
+
+		resp, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{
+			ActorUserId:     "actor1",
+			RecipientUserId: "recipient1",
+			DecisionType:    grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.MutualLikes)
+		assert.True(t, resp.NewMatch)
+		assert.Equal(t, entity.MatchID("recipient1", "actor1"), resp.MatchId)
+		assert.Equal(t, uint64(1736121600), resp.GetMatchedAt())
+		assert.Equal(t, grpclibs.DecisionType_DECISION_TYPE_PASS, resp.GetPreviousDecisionType())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("FallsBackToLikedRecipient", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypeLike).
+			Return(entity.DecisionOutcome{}, nil)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{
+			ActorUserId:     "actor1",
+			RecipientUserId: "recipient1",
+			LikedRecipient:  true,
+		})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{
+			ActorUserId:     "actor1",
+			RecipientUserId: "recipient1",
+			DecisionType:    grpclibs.DecisionType(42),
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision")
+	})
+}
+
+func TestPutDecision_Validation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		req    *grpclibs.PutDecisionRequest
+		fields []string
+	}{
+		{
+			name:   "SelfDecision",
+			req:    &grpclibs.PutDecisionRequest{ActorUserId: "user1", RecipientUserId: "user1", LikedRecipient: true},
+			fields: []string{"recipient_user_id"},
+		},
+		{
+			name:   "IDTooLong",
+			req:    &grpclibs.PutDecisionRequest{ActorUserId: strings.Repeat("1", 10*1024), RecipientUserId: "user2"},
+			fields: []string{"actor_user_id"},
+		},
+		{
+			name:   "WhitespaceIDs",
+			req:    &grpclibs.PutDecisionRequest{ActorUserId: "  ", RecipientUserId: "user2 "},
+			fields: []string{"actor_user_id", "recipient_user_id"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := new(repository.MockDecisionRepository)
+			s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+			_, err := s.PutDecision(ctx, test.req)
+
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.Len(t, status.Convert(err).Details(), 1)
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			fields := make([]string, 0, len(badRequest.FieldViolations))
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+			assert.Equal(t, test.fields, fields)
+			repo.AssertNotCalled(t, "CreateOrUpdateDecision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("NumericIDs", func(t *testing.T) {
+		config := testConfig
+		config.Validation = validation.Rules{IDFormat: validation.IDFormatNumeric, MaxIDLength: validation.MaxIDLength}
+		s := NewExploreGRPCServer(new(repository.MockDecisionRepository), new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, config)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{ActorUserId: "123", RecipientUserId: "user2"})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "recipient_user_id must be a positive integer without leading zeros")
+	})
+}
+
+func TestPutDecision_UnknownUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PutDecision", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		users := new(repository.MockUserLookup)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, users, testConfig)
+
+		users.On("FindMissingUsers", mock.Anything, []string{"1", "2"}).Return([]string{"2"}, nil)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{ActorUserId: "1", RecipientUserId: "2", LikedRecipient: true})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("PutDecisions", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		users := new(repository.MockUserLookup)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, users, testConfig)
+
+		users.On("FindMissingUsers", mock.Anything, []string{"1", "2", "3"}).Return([]string{"2"}, nil)
+		repo.On("CreateOrUpdateDecisions", mock.Anything, "1", []entity.PendingDecision{
+			{RecipientID: "3", Type: entity.DecisionTypeLike},
+		}).Return([]entity.DecisionResult{{RecipientID: "3"}}, nil)
+
+		resp, err := s.PutDecisions(ctx, &grpclibs.PutDecisionsRequest{
+			ActorUserId: "1",
+			Decisions: []*grpclibs.PutDecisionsRequest_Decision{
+				{RecipientUserId: "2", LikedRecipient: true},
+				{RecipientUserId: "3", LikedRecipient: true},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, uint32(codes.NotFound), resp.Results[0].GetError().GetCode())
+		assert.Nil(t, resp.Results[1].Error)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("PutDecisionsUnknownActor", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		users := new(repository.MockUserLookup)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, users, testConfig)
+
+		users.On("FindMissingUsers", mock.Anything, []string{"1", "2"}).Return([]string{"1"}, nil)
+
+		_, err := s.PutDecisions(ctx, &grpclibs.PutDecisionsRequest{
+			ActorUserId: "1",
+			Decisions:   []*grpclibs.PutDecisionsRequest_Decision{{RecipientUserId: "2"}},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecisions", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPutDecision_IdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	req := &grpclibs.PutDecisionRequest{
+		ActorUserId:     "actor1",
+		RecipientUserId: "recipient1",
+		DecisionType:    grpclibs.DecisionType_DECISION_TYPE_LIKE,
+		IdempotencyKey:  "key1",
+	}
+	requestHash, err := hashIdempotentRequest(req)
+	require.NoError(t, err)
+
+	t.Run("FirstRequest", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		idempotency := new(repository.MockIdempotencyRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), idempotency, pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		idempotency.On("ClaimKey", mock.Anything, "actor1", "key1", requestHash, idempotencyClaimTTL).Return(nil, nil)
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypeLike).Return(entity.DecisionOutcome{}, nil)
+		stored, err := proto.Marshal(&grpclibs.PutDecisionResponse{})
+		require.NoError(t, err)
+		idempotency.On("PutResponse", mock.Anything, "actor1", "key1", entity.StoredResponse{RequestHash: requestHash, Response: stored}, testConfig.IdempotencyTTL).Return(nil)
+
+		resp, err := s.PutDecision(ctx, req)
+
+		require.NoError(t, err)
+		assert.False(t, resp.MutualLikes)
+		repo.AssertExpectations(t)
+		idempotency.AssertExpectations(t)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		idempotency := new(repository.MockIdempotencyRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), idempotency, pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		stored, err := proto.Marshal(&grpclibs.PutDecisionResponse{MutualLikes: true})
+		require.NoError(t, err)
+		idempotency.On("ClaimKey", mock.Anything, "actor1", "key1", requestHash, idempotencyClaimTTL).
+			Return(&entity.StoredResponse{RequestHash: requestHash, Response: stored}, nil)
+
+		resp, err := s.PutDecision(ctx, req)
+
+		require.NoError(t, err)
+		assert.True(t, resp.MutualLikes)
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision")
+	})
+
+	t.Run("KeyReusedForDifferentRequest", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		idempotency := new(repository.MockIdempotencyRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), idempotency, pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		idempotency.On("ClaimKey", mock.Anything, "actor1", "key1", requestHash, idempotencyClaimTTL).
+			Return(&entity.StoredResponse{RequestHash: []byte("other"), Response: nil}, nil)
+
+		_, err := s.PutDecision(ctx, req)
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision")
+	})
+
+	t.Run("RetryWhileInProgress", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		idempotency := new(repository.MockIdempotencyRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), idempotency, pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		// The original request has claimed the key but not stored its response yet
+		idempotency.On("ClaimKey", mock.Anything, "actor1", "key1", requestHash, idempotencyClaimTTL).
+			Return(&entity.StoredResponse{RequestHash: requestHash, Pending: true}, nil)
+
+		_, err := s.PutDecision(ctx, req)
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision")
+		idempotency.AssertNotCalled(t, "ReleaseKey")
+	})
+
+	t.Run("FailedDecisionReleasesKey", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		idempotency := new(repository.MockIdempotencyRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), idempotency, pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		idempotency.On("ClaimKey", mock.Anything, "actor1", "key1", requestHash, idempotencyClaimTTL).Return(nil, nil)
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypeLike).
+			Return(entity.DecisionOutcome{}, errors.New("database error"))
+		idempotency.On("ReleaseKey", mock.Anything, "actor1", "key1").Return(nil)
+
+		_, err := s.PutDecision(ctx, req)
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		idempotency.AssertExpectations(t)
+		idempotency.AssertNotCalled(t, "PutResponse")
+	})
+}
+
+func TestPutDecisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("InvalidDecisionsDontFailBatch", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("CreateOrUpdateDecisions", mock.Anything, "actor1", []entity.PendingDecision{
+			{RecipientID: "recipient1", Type: entity.DecisionTypeLike},
+			{RecipientID: "recipient2", Type: entity.DecisionTypePass},
+		}).Return([]entity.DecisionResult{
+			{RecipientID: "recipient1", Outcome: entity.DecisionOutcome{Matched: true}},
+			{RecipientID: "recipient2", Err: errors.New("database error")},
+		}, nil)
+
+		resp, err := s.PutDecisions(ctx, &grpclibs.PutDecisionsRequest{
+			ActorUserId: "actor1",
+			Decisions: []*grpclibs.PutDecisionsRequest_Decision{
+				{RecipientUserId: "recipient1", LikedRecipient: true},
+				{RecipientUserId: ""},
+				{RecipientUserId: "recipient2"},
+				{RecipientUserId: "recipient1"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 4)
+		assert.True(t, resp.Results[0].MutualLikes)
+		assert.Nil(t, resp.Results[0].Error)
+		assert.Equal(t, uint32(codes.InvalidArgument), resp.Results[1].GetError().GetCode())
+		assert.Equal(t, uint32(codes.Internal), resp.Results[2].GetError().GetCode())
+		assert.Equal(t, uint32(codes.InvalidArgument), resp.Results[3].GetError().GetCode())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("TooManyDecisions", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		decisions := make([]*grpclibs.PutDecisionsRequest_Decision, testConfig.MaxBatchSize+1)
+		for i := range decisions {
+			decisions[i] = &grpclibs.PutDecisionsRequest_Decision{RecipientUserId: fmt.Sprintf("recipient%d", i)}
+		}
+
+		_, err := s.PutDecisions(ctx, &grpclibs.PutDecisionsRequest{ActorUserId: "actor1", Decisions: decisions})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertNotCalled(t, "CreateOrUpdateDecisions")
+	})
+}
+
+func TestLikeQuota(t *testing.T) {
+	ctx := context.Background()
+	limits := map[quota.Tier]uint32{quota.TierFree: 2}
+
+	t.Run("PutDecisionExhausted", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		quotas := quota.NewLimiter(repo, quota.FixedTier(quota.TierFree), limits, 24*time.Hour)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), quotas, repository.UncheckedUserLookup{}, testConfig)
+
+		oldest := time.Now().Add(-23 * time.Hour)
+		repo.On("ListLikeTimesByActor", mock.Anything, "actor1", mock.AnythingOfType("time.Time"), 2).
+			Return([]time.Time{time.Now(), oldest}, nil)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{ActorUserId: "actor1", RecipientUserId: "recipient1", LikedRecipient: true})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Len(t, status.Convert(err).Details(), 1)
+		retryInfo, ok := status.Convert(err).Details()[0].(*errdetails.RetryInfo)
+		require.True(t, ok)
+		assert.InDelta(t, time.Hour.Seconds(), retryInfo.RetryDelay.AsDuration().Seconds(), 5)
+		repo.AssertNotCalled(t, "CreateOrUpdateDecision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("PutDecisionPassNotLimited", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		quotas := quota.NewLimiter(repo, quota.FixedTier(quota.TierFree), limits, 24*time.Hour)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), quotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypePass).Return(entity.DecisionOutcome{}, nil)
+
+		_, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{ActorUserId: "actor1", RecipientUserId: "recipient1"})
+
+		require.NoError(t, err)
+		repo.AssertNotCalled(t, "ListLikeTimesByActor", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("PutDecisionsRejectsLikesOverQuota", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		quotas := quota.NewLimiter(repo, quota.FixedTier(quota.TierFree), limits, 24*time.Hour)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), quotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListLikeTimesByActor", mock.Anything, "actor1", mock.AnythingOfType("time.Time"), 2).
+			Return([]time.Time{time.Now().Add(-time.Hour)}, nil)
+		repo.On("CreateOrUpdateDecisions", mock.Anything, "actor1", []entity.PendingDecision{
+			{RecipientID: "recipient1", Type: entity.DecisionTypeLike},
+			{RecipientID: "recipient3", Type: entity.DecisionTypePass},
+		}).Return([]entity.DecisionResult{
+			{RecipientID: "recipient1"},
+			{RecipientID: "recipient3"},
+		}, nil)
+
+		resp, err := s.PutDecisions(ctx, &grpclibs.PutDecisionsRequest{
+			ActorUserId: "actor1",
+			Decisions: []*grpclibs.PutDecisionsRequest_Decision{
+				{RecipientUserId: "recipient1", LikedRecipient: true},
+				{RecipientUserId: "recipient2", DecisionType: grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE},
+				{RecipientUserId: "recipient3"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+		assert.Nil(t, resp.Results[0].Error)
+		assert.Equal(t, uint32(codes.ResourceExhausted), resp.Results[1].GetError().GetCode())
+		assert.Nil(t, resp.Results[2].Error)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("GetQuota", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		quotas := quota.NewLimiter(repo, quota.FixedTier(quota.TierFree), limits, 24*time.Hour)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), quotas, repository.UncheckedUserLookup{}, testConfig)
+
+		likedAt := time.Now().Add(-time.Hour)
+		repo.On("ListLikeTimesByActor", mock.Anything, "user1", mock.AnythingOfType("time.Time"), 2).
+			Return([]time.Time{likedAt}, nil)
+
+		resp, err := s.GetQuota(ctx, &grpclibs.GetQuotaRequest{UserId: "user1"})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Unlimited)
+		assert.Equal(t, uint32(2), resp.Limit)
+		assert.Equal(t, uint32(1), resp.Remaining)
+		assert.Equal(t, uint64(likedAt.Add(24*time.Hour).Unix()), resp.GetResetsAt())
+		assert.Equal(t, uint64(24*60*60), resp.WindowSeconds)
+	})
+}
+
+func TestListMyDecisions(t *testing.T) {
+	ctx := context.Background()
+	cursor := &entity.Cursor{UpdatedAt: time.Unix(1738300000, 0).UTC(), ActorId: "recipient5"}
+
+	t.Run("Filter", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListDecisionsByActor", mock.Anything, "actor1", entity.DecisionFilterPassed, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Decision]{Items: []entity.Decision{{ActorID: "actor1", RecipientID: "recipient1", UpdatedAt: cursor.UpdatedAt}}}, nil)
+
+		resp, err := s.ListMyDecisions(ctx, &grpclibs.ListMyDecisionsRequest{
+			ActorUserId: "actor1",
+			Filter:      grpclibs.DecisionFilter_DECISION_FILTER_PASSED,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Decisions, 1)
+		assert.Equal(t, "recipient1", resp.Decisions[0].RecipientUserId)
+		assert.False(t, resp.Decisions[0].LikedRecipient)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("TokenIssuedForAnotherFilter", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("ListDecisionsByActor", mock.Anything, "actor1", entity.DecisionFilterLiked, (*entity.Cursor)(nil), 50).
+			Return(&entity.Page[entity.Decision]{NextCursor: cursor}, nil)
+
+		resp, err := s.ListMyDecisions(ctx, &grpclibs.ListMyDecisionsRequest{
+			ActorUserId: "actor1",
+			Filter:      grpclibs.DecisionFilter_DECISION_FILTER_LIKED,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.NextPaginationToken)
+
+		_, err = s.ListMyDecisions(ctx, &grpclibs.ListMyDecisionsRequest{
+			ActorUserId:     "actor1",
+			Filter:          grpclibs.DecisionFilter_DECISION_FILTER_ALL,
+			PaginationToken: resp.NextPaginationToken,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestUndoDecision(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RestoresSuperLike", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		superLike := entity.DecisionTypeSuperLike
+		repo.On("UndoDecision", mock.Anything, "actor1", "recipient1", mock.Anything).
+			Return(entity.UndoResult{Restored: &superLike}, nil)
+
+		resp, err := s.UndoDecision(ctx, &grpclibs.UndoDecisionRequest{ActorUserId: "actor1", RecipientUserId: "recipient1"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.GetLikedRecipient())
+		assert.Equal(t, grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE, resp.GetDecisionType())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("BlockedLooksLikeNothingToUndo", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("UndoDecision", mock.Anything, "actor1", "recipient1", mock.Anything).
+			Return(entity.UndoResult{}, repository.ErrBlocked)
+
+		_, err := s.UndoDecision(ctx, &grpclibs.UndoDecisionRequest{ActorUserId: "actor1", RecipientUserId: "recipient1"})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NotContains(t, status.Convert(err).Message(), "blocked")
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BlockSelf", func(t *testing.T) {
+		blocks := new(repository.MockBlockRepository)
+		s := NewExploreGRPCServer(new(repository.MockDecisionRepository), blocks, new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		_, err := s.Block(ctx, &grpclibs.BlockRequest{BlockerUserId: "user1", BlockedUserId: "user1"})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		blocks.AssertNotCalled(t, "Block")
+	})
+
+	t.Run("DecisionAfterBlock", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](1), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		repo.On("CreateOrUpdateDecision", mock.Anything, "user1", "user2", entity.DecisionTypeLike).
+			Return(entity.DecisionOutcome{}, repository.ErrBlocked)
+
+		events := s.likes.Subscribe("user2")
+		defer events.Unsubscribe()
+
+		resp, err := s.PutDecision(ctx, &grpclibs.PutDecisionRequest{
+			ActorUserId:     "user1",
+			RecipientUserId: "user2",
+			LikedRecipient:  true,
+		})
+
+		// The block isn't revealed, the like just doesn't match
+		require.NoError(t, err)
+		assert.False(t, resp.MutualLikes)
+		assert.Empty(t, resp.MatchId)
+		assert.Len(t, events.Messages(), 0)
+		repo.AssertExpectations(t)
+	})
+}
+
+// fakeWatchStream records what WatchLikedYou sends, signalling once the handler is waiting for events
+// and whenever it starts sending one
+type fakeWatchStream struct {
+	grpc.ServerStreamingServer[grpclibs.WatchLikedYouResponse]
+	ctx      context.Context
+	watching chan struct{}
+	once     sync.Once
+	sending  chan struct{}
+	sent     chan *grpclibs.WatchLikedYouResponse
+}
+
+func newFakeWatchStream(ctx context.Context) *fakeWatchStream {
+	return &fakeWatchStream{
+		ctx:      ctx,
+		watching: make(chan struct{}),
+		sending:  make(chan struct{}, 8),
+		sent:     make(chan *grpclibs.WatchLikedYouResponse),
+	}
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	f.once.Do(func() { close(f.watching) })
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(response *grpclibs.WatchLikedYouResponse) error {
+	f.sending <- struct{}{}
+	f.sent <- response
+	return nil
+}
+
+func TestWatchLikedYou(t *testing.T) {
+	t.Run("LikeAndMatch", func(t *testing.T) {
+		repo := new(repository.MockDecisionRepository)
+		s := NewExploreGRPCServer(repo, new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), pubsub.NewBroker[entity.LikeEvent](4), unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := newFakeWatchStream(ctx)
+		done := make(chan error, 1)
+		go func() { done <- s.WatchLikedYou(&grpclibs.WatchLikedYouRequest{RecipientUserId: "recipient1"}, stream) }()
+		<-stream.watching
+
+		decidedAt := time.Unix(1736121600, 0)
+		repo.On("CreateOrUpdateDecision", mock.Anything, "actor1", "recipient1", entity.DecisionTypeSuperLike).
+			Return(entity.DecisionOutcome{DecidedAt: decidedAt, Matched: true, NewMatch: true, MatchedAt: decidedAt}, nil)
+
+		_, err := s.PutDecision(context.Background(), &grpclibs.PutDecisionRequest{
+			ActorUserId:     "actor1",
+			RecipientUserId: "recipient1",
+			DecisionType:    grpclibs.DecisionType_DECISION_TYPE_SUPER_LIKE,
+		})
+		require.NoError(t, err)
+
+		liker := (<-stream.sent).GetLiker()
+		require.NotNil(t, liker)
+		assert.Equal(t, "actor1", liker.ActorId)
+		assert.Equal(t, uint64(1736121600), liker.UnixTimestamp)
+		assert.True(t, liker.SuperLiked)
+
+		match := (<-stream.sent).GetMatch()
+		require.NotNil(t, match)
+		assert.Equal(t, "actor1", match.UserId)
+		assert.Equal(t, entity.MatchID("actor1", "recipient1"), match.MatchId)
+
+		cancel()
+		assert.Equal(t, codes.Canceled, status.Code(<-done))
+	})
+
+	t.Run("FallsBehind", func(t *testing.T) {
+		likes := pubsub.NewBroker[entity.LikeEvent](1)
+		s := NewExploreGRPCServer(new(repository.MockDecisionRepository), new(repository.MockBlockRepository), new(repository.MockIdempotencyRepository), likes, unlimitedQuotas, repository.UncheckedUserLookup{}, testConfig)
+
+		stream := newFakeWatchStream(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- s.WatchLikedYou(&grpclibs.WatchLikedYouRequest{RecipientUserId: "recipient1"}, stream) }()
+		<-stream.watching
+
+		// The first event is held up in Send, the second fills the buffer and the third drops the stream
+		likes.Publish("recipient1", entity.LikeEvent{Liker: &entity.Liker{ActorID: "actor1"}})
+		<-stream.sending
+		likes.Publish("recipient1", entity.LikeEvent{Liker: &entity.Liker{ActorID: "actor2"}})
+		likes.Publish("recipient1", entity.LikeEvent{Liker: &entity.Liker{ActorID: "actor3"}})
+
+		assert.Equal(t, "actor1", (<-stream.sent).GetLiker().GetActorId())
+		assert.Equal(t, "actor2", (<-stream.sent).GetLiker().GetActorId())
+		assert.Equal(t, codes.ResourceExhausted, status.Code(<-done))
+	})
+}
@@ -0,0 +1,146 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxIDLength is the longest user ID the database can store
+const MaxIDLength = 255
+
+// MaxUnixTimestamp is the latest time the database's TIMESTAMP columns can hold
+const MaxUnixTimestamp = 1<<31 - 1
+
+// IDFormat is the shape user IDs must have
+type IDFormat string
+
+const (
+	// IDFormatAny accepts any printable ID without surrounding whitespace
+	IDFormatAny IDFormat = "any"
+	// IDFormatNumeric accepts positive integers without leading zeros, as stored in an INT column
+	IDFormatNumeric IDFormat = "numeric"
+	// IDFormatUUID accepts hyphenated UUIDs
+	IDFormatUUID IDFormat = "uuid"
+)
+
+var (
+	numericID = regexp.MustCompile(`^[1-9][0-9]*$`)
+	uuidID    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// ParseIDFormat returns the ID format with the name
+func ParseIDFormat(name string) (IDFormat, error) {
+	switch format := IDFormat(name); format {
+	case IDFormatAny, IDFormatNumeric, IDFormatUUID:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown ID format %q, expected any, numeric or uuid", name)
+	}
+}
+
+// Rules are what a valid request looks like
+type Rules struct {
+	IDFormat IDFormat
+	// MaxIDLength is the longest ID in bytes, at most MaxIDLength
+	MaxIDLength int
+}
+
+// UserIDViolation describes what's wrong with the ID, empty if it's valid
+func (r Rules) UserIDViolation(id string) string {
+	switch {
+	case id == "":
+		return "is required"
+	case len(id) > r.MaxIDLength:
+		return fmt.Sprintf("must be at most %d bytes", r.MaxIDLength)
+	case strings.TrimSpace(id) != id:
+		return "must not start or end with whitespace"
+	case strings.IndexFunc(id, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0:
+		return "must only contain printable characters"
+	case r.IDFormat == IDFormatNumeric && !numericID.MatchString(id):
+		return "must be a positive integer without leading zeros"
+	case r.IDFormat == IDFormatUUID && !uuidID.MatchString(id):
+		return "must be a UUID"
+	default:
+		return ""
+	}
+}
+
+// Validator collects the field violations of a request
+type Validator struct {
+	rules      Rules
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func New(rules Rules) *Validator {
+	return &Validator{
+		rules: rules,
+	}
+}
+
+// UserID checks the ID in the field against the rules
+func (v *Validator) UserID(field string, id string) {
+	if description := v.rules.UserIDViolation(id); description != "" {
+		v.Add(field, description)
+	}
+}
+
+// NotSelf rejects a request where the user in the field is the same as the user in the other field,
+// such as someone deciding on themselves
+func (v *Validator) NotSelf(field string, id string, otherField string, otherID string) {
+	if id != "" && id == otherID {
+		v.Add(field, "must differ from "+otherField)
+	}
+}
+
+// UnixTimestamp checks the timestamp in the field, if it's set, is within the range the database can compare against
+func (v *Validator) UnixTimestamp(field string, timestamp *uint64) {
+	if timestamp != nil && *timestamp > MaxUnixTimestamp {
+		v.Add(field, fmt.Sprintf("must be at most %d", MaxUnixTimestamp))
+	}
+}
+
+// Check adds the violation unless ok is true
+func (v *Validator) Check(ok bool, field string, description string) {
+	if !ok {
+		v.Add(field, description)
+	}
+}
+
+func (v *Validator) Add(field string, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// Err returns an InvalidArgument error with a BadRequest detail listing every violation, nil if there are none
+func (v *Validator) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(v.violations))
+	for _, violation := range v.violations {
+		messages = append(messages, violation.GetField()+" "+violation.GetDescription())
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// FieldError returns the InvalidArgument error for a request with a single invalid field
+func FieldError(field string, description string) error {
+	v := &Validator{}
+	v.Add(field, description)
+	return v.Err()
+}
@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserIDViolation(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    IDFormat
+		id        string
+		violation string
+	}{
+		{"Valid", IDFormatAny, "user1", ""},
+		{"Empty", IDFormatAny, "", "is required"},
+		{"TooLong", IDFormatAny, strings.Repeat("1", 65), "must be at most 64 bytes"},
+		{"Whitespace", IDFormatAny, " user1", "must not start or end with whitespace"},
+		{"OnlyWhitespace", IDFormatAny, "   ", "must not start or end with whitespace"},
+		{"ControlCharacter", IDFormatAny, "user\x001", "must only contain printable characters"},
+		{"Numeric", IDFormatNumeric, "12345", ""},
+		{"NotNumeric", IDFormatNumeric, "user1", "must be a positive integer without leading zeros"},
+		{"LeadingZero", IDFormatNumeric, "007", "must be a positive integer without leading zeros"},
+		{"Zero", IDFormatNumeric, "0", "must be a positive integer without leading zeros"},
+		{"UUID", IDFormatUUID, "8f14e45f-ceea-467f-a0e6-7c2b5e0b1f3a", ""},
+		{"NotUUID", IDFormatUUID, "8f14e45fceea467fa0e67c2b5e0b1f3a", "must be a UUID"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules := Rules{IDFormat: test.format, MaxIDLength: 64}
+			assert.Equal(t, test.violation, rules.UserIDViolation(test.id))
+		})
+	}
+}
+
+func TestUnixTimestamp(t *testing.T) {
+	timestamp := func(v uint64) *uint64 { return &v }
+
+	tests := []struct {
+		name      string
+		timestamp *uint64
+		valid     bool
+	}{
+		{"Unset", nil, true},
+		{"Zero", timestamp(0), true},
+		{"Latest", timestamp(MaxUnixTimestamp), true},
+		{"TooLate", timestamp(MaxUnixTimestamp + 1), false},
+		{"WouldWrap", timestamp(1 << 63), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := New(Rules{IDFormat: IDFormatAny, MaxIDLength: MaxIDLength})
+			v.UnixTimestamp("since", test.timestamp)
+			assert.Equal(t, test.valid, v.Err() == nil)
+		})
+	}
+}
+
+func TestErr(t *testing.T) {
+	t.Run("NoViolations", func(t *testing.T) {
+		v := New(Rules{IDFormat: IDFormatAny, MaxIDLength: MaxIDLength})
+		v.UserID("actor_user_id", "user1")
+
+		assert.NoError(t, v.Err())
+	})
+
+	t.Run("ListsEveryViolation", func(t *testing.T) {
+		v := New(Rules{IDFormat: IDFormatAny, MaxIDLength: MaxIDLength})
+		v.UserID("actor_user_id", "")
+		v.UserID("recipient_user_id", "user1")
+		v.NotSelf("recipient_user_id", "user1", "actor_user_id", "user1")
+
+		err := v.Err()
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Len(t, status.Convert(err).Details(), 1)
+		badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.FieldViolations, 2)
+		assert.Equal(t, "actor_user_id", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "is required", badRequest.FieldViolations[0].Description)
+		assert.Equal(t, "recipient_user_id", badRequest.FieldViolations[1].Field)
+		assert.Equal(t, "must differ from actor_user_id", badRequest.FieldViolations[1].Description)
+	})
+}
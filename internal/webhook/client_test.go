@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicHost(t *testing.T) {
+	for host, public := range map[string]bool{
+		"partner.example.com": true,
+		"93.184.216.34":       true,
+		"2606:2800:220:1::1":  true,
+		"localhost":           false,
+		"api.localhost.":      false,
+		"127.0.0.1":           false,
+		"::1":                 false,
+		"0.0.0.0":             false,
+		"10.1.2.3":            false,
+		"172.16.0.1":          false,
+		"192.168.1.1":         false,
+		"100.64.0.1":          false,
+		"169.254.169.254":     false,
+		"fe80::1":             false,
+		"fd00::1":             false,
+		"::ffff:127.0.0.1":    false,
+	} {
+		assert.Equal(t, public, IsPublicHost(host), host)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	deliverer := NewDeliverer(NewClient(time.Second), 3, time.Millisecond, time.Millisecond)
+
+	err := deliverer.DeliverOnce(context.Background(), entity.WebhookEndpoint{ID: 1, URL: server.URL}, []byte(`{"id":1}`))
+
+	// A private address won't become public, so it isn't retried
+	require.ErrorIs(t, err, ErrPrivateAddress)
+	_, retry := deliverer.retryDelay(1, err)
+	assert.False(t, retry)
+	assert.Zero(t, calls)
+}
+
+func TestNewClientDoesNotFollowRedirects(t *testing.T) {
+	client := NewClient(time.Second)
+
+	req := httptest.NewRequest(http.MethodPost, "https://partner.example.com/hooks", nil)
+	assert.ErrorIs(t, client.CheckRedirect(req, []*http.Request{req}), http.ErrUseLastResponse)
+}
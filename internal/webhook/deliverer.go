@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+)
+
+// SignatureHeader carries the payload's signature, as t=<unix seconds>,v1=<hex HMAC-SHA256>.
+// Endpoints verify it by signing "<t>.<body>" with their secret and should reject stale timestamps.
+const SignatureHeader = "X-Explore-Signature"
+
+// Sign returns the signature of the payload sent at the time
+func Sign(secret []byte, timestamp time.Time, payload []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deliverer posts signed payloads to webhook endpoints, and decides when a failed delivery is tried again
+type Deliverer struct {
+	client *http.Client
+	// maxAttempts is how many times a payload is sent before giving up
+	maxAttempts int
+	// baseDelay is the wait before the first retry, doubling with every retry after it up to maxDelay
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+func NewDeliverer(client *http.Client, maxAttempts int, baseDelay time.Duration, maxDelay time.Duration) *Deliverer {
+	return &Deliverer{
+		client:      client,
+		maxAttempts: maxAttempts,
+		baseDelay:   baseDelay,
+		maxDelay:    maxDelay,
+	}
+}
+
+// retryDelay returns how long to wait before trying a payload again after the attempts that have failed, the last
+// with the error. It backs off exponentially, and reports false once the failure is permanent or every attempt is used.
+func (d *Deliverer) retryDelay(attempts int, err error) (time.Duration, bool) {
+	var permanent permanentError
+	if errors.As(err, &permanent) || attempts >= d.maxAttempts {
+		return 0, false
+	}
+
+	delay := d.baseDelay
+	for retry := 1; retry < attempts && delay < d.maxDelay; retry++ {
+		delay *= 2
+	}
+	return min(delay, d.maxDelay), true
+}
+
+// DeliverOnce sends the payload to the endpoint a single time
+func (d *Deliverer) DeliverOnce(ctx context.Context, endpoint entity.WebhookEndpoint, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return permanentError{fmt.Errorf("failed to create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), payload))
+
+	resp, err := d.client.Do(req)
+	if errors.Is(err, ErrPrivateAddress) {
+		return permanentError{fmt.Errorf("failed to post to webhook %d: %w", endpoint.ID, err)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook %d: %w", endpoint.ID, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096)) // Drain so the connection can be reused
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = fmt.Errorf("webhook %d responded %s", endpoint.ID, resp.Status)
+	switch {
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusRequestTimeout:
+		return err
+	default:
+		// Any other client error won't go away by sending the same payload again
+		return permanentError{err}
+	}
+}
+
+// permanentError is a failure that retrying won't fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	timestamp := time.Unix(1736510400, 0)
+
+	signature := Sign([]byte("secret"), timestamp, []byte(`{"id":1}`))
+
+	assert.Equal(t, "t=1736510400,v1=3ce7bf046816756da321234f4c09f61b16a58622aeb760771ba79792932616d9", signature)
+	assert.NotEqual(t, signature, Sign([]byte("other"), timestamp, []byte(`{"id":1}`)))
+	assert.NotEqual(t, signature, Sign([]byte("secret"), timestamp.Add(time.Second), []byte(`{"id":1}`)))
+}
+
+func TestDeliver(t *testing.T) {
+	ctx := context.Background()
+	payload := []byte(`{"id":1,"type":"match"}`)
+
+	t.Run("SignsPayload", func(t *testing.T) {
+		var body []byte
+		var signature string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			signature = r.Header.Get(SignatureHeader)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		endpoint := entity.WebhookEndpoint{ID: 1, URL: server.URL, Secret: []byte("secret")}
+		deliverer := NewDeliverer(server.Client(), 3, time.Millisecond, time.Millisecond)
+
+		err := deliverer.DeliverOnce(ctx, endpoint, payload)
+
+		require.NoError(t, err)
+		assert.Equal(t, payload, body)
+		// The signature is only valid for the second it was sent in
+		assert.Contains(t, []string{
+			Sign(endpoint.Secret, time.Now(), payload),
+			Sign(endpoint.Secret, time.Now().Add(-time.Second), payload),
+		}, signature)
+	})
+
+	t.Run("RetriesServerErrors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		endpoint := entity.WebhookEndpoint{ID: 1, URL: server.URL, Secret: []byte("secret")}
+		deliverer := NewDeliverer(server.Client(), 3, time.Millisecond, time.Millisecond)
+
+		err := deliverer.DeliverOnce(ctx, endpoint, payload)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "503 Service Unavailable")
+		_, retry := deliverer.retryDelay(1, err)
+		assert.True(t, retry)
+	})
+
+	t.Run("DoesNotRetryClientErrors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer server.Close()
+
+		endpoint := entity.WebhookEndpoint{ID: 1, URL: server.URL, Secret: []byte("secret")}
+		deliverer := NewDeliverer(server.Client(), 3, time.Millisecond, time.Millisecond)
+
+		err := deliverer.DeliverOnce(ctx, endpoint, payload)
+
+		require.Error(t, err)
+		_, retry := deliverer.retryDelay(1, err)
+		assert.False(t, retry)
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	deliverer := NewDeliverer(http.DefaultClient, 5, time.Second, 3*time.Second)
+	err := errors.New("webhook 1 responded 502 Bad Gateway")
+
+	// The delay doubles with every failed attempt up to the maximum
+	for attempts, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 3 * time.Second, 4: 3 * time.Second} {
+		delay, retry := deliverer.retryDelay(attempts, err)
+		assert.True(t, retry)
+		assert.Equal(t, expected, delay, "after %d attempts", attempts)
+	}
+
+	// Gives up once every attempt is used
+	_, retry := deliverer.retryDelay(5, err)
+	assert.False(t, retry)
+}
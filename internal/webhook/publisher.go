@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/outbox"
+	"github.com/shewitt93/explore_service/internal/repository"
+)
+
+// Publisher queues outbox events for the webhook endpoints registered for them.
+// It only writes the deliveries, the Worker sends them, so an endpoint that's slow or down never holds back the outbox.
+type Publisher struct {
+	repo repository.WebhookRepository
+}
+
+// Ensure Publisher implements the outbox's Publisher interface
+var _ outbox.Publisher = (*Publisher)(nil)
+
+func NewPublisher(repo repository.WebhookRepository) *Publisher {
+	return &Publisher{
+		repo: repo,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	// Only likes and matches are offered to partners
+	if event.Type != entity.OutboxEventLike && event.Type != entity.OutboxEventMatch {
+		return nil
+	}
+
+	endpoints, err := p.repo.ListEndpointsForEvent(ctx, event.Type)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(outbox.NewMessage(event))
+	if err != nil {
+		return fmt.Errorf("failed to encode event %d: %w", event.ID, err)
+	}
+
+	deliveries := make([]entity.WebhookDelivery, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		deliveries = append(deliveries, entity.WebhookDelivery{Endpoint: endpoint, EventID: event.ID, Payload: payload})
+	}
+
+	return p.repo.EnqueueDeliveries(ctx, deliveries)
+}
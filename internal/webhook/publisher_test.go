@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/outbox"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+	event := entity.OutboxEvent{
+		ID: 7, Type: entity.OutboxEventMatch, ActorID: "actor1", RecipientID: "recipient1",
+		CreatedAt: time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+	}
+	payload, err := json.Marshal(outbox.NewMessage(event))
+	require.NoError(t, err)
+
+	t.Run("QueuesDeliveryPerEndpoint", func(t *testing.T) {
+		repo := new(repository.MockWebhookRepository)
+		publisher := NewPublisher(repo)
+
+		endpoints := []entity.WebhookEndpoint{
+			{ID: 1, URL: "https://one.example.com/hooks", Secret: []byte("secret1")},
+			{ID: 2, URL: "https://two.example.com/hooks", Secret: []byte("secret2")},
+		}
+		repo.On("ListEndpointsForEvent", mock.Anything, entity.OutboxEventMatch).Return(endpoints, nil)
+		repo.On("EnqueueDeliveries", mock.Anything, []entity.WebhookDelivery{
+			{Endpoint: endpoints[0], EventID: 7, Payload: payload},
+			{Endpoint: endpoints[1], EventID: 7, Payload: payload},
+		}).Return(nil)
+
+		// Nothing is sent, so this doesn't wait on the endpoints
+		err := publisher.Publish(ctx, event)
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("EnqueueError", func(t *testing.T) {
+		repo := new(repository.MockWebhookRepository)
+		publisher := NewPublisher(repo)
+
+		repo.On("ListEndpointsForEvent", mock.Anything, entity.OutboxEventMatch).Return([]entity.WebhookEndpoint{
+			{ID: 1, URL: "https://one.example.com/hooks", Secret: []byte("secret1")},
+		}, nil)
+		repo.On("EnqueueDeliveries", mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+		// The relay has to try the event again so it isn't lost
+		err := publisher.Publish(ctx, event)
+
+		require.Error(t, err)
+	})
+
+	t.Run("IgnoresPasses", func(t *testing.T) {
+		repo := new(repository.MockWebhookRepository)
+		publisher := NewPublisher(repo)
+
+		err := publisher.Publish(ctx, entity.OutboxEvent{ID: 8, Type: entity.OutboxEventPass, ActorID: "actor1", RecipientID: "recipient1"})
+
+		require.NoError(t, err)
+		repo.AssertNotCalled(t, "ListEndpointsForEvent", mock.Anything, mock.Anything)
+	})
+}
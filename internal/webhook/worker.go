@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/repository"
+)
+
+// Worker sends the deliveries queued by Publisher. A failed delivery is scheduled again with backoff, and dead-lettered
+// once it fails permanently or every attempt is used, so it can be replayed after the endpoint recovers.
+// Deliveries are sent at least once and retries can overtake an endpoint's later events, so partners order them by
+// sequence. Only one worker should run at a time, as two would send the same deliveries.
+type Worker struct {
+	repo      repository.WebhookRepository
+	deliverer *Deliverer
+	// batchSize is the most deliveries sent at once
+	batchSize int
+	// interval is how long to wait before polling again once no deliveries are due
+	interval time.Duration
+}
+
+func NewWorker(repo repository.WebhookRepository, deliverer *Deliverer, batchSize int, interval time.Duration) *Worker {
+	return &Worker{
+		repo:      repo,
+		deliverer: deliverer,
+		batchSize: batchSize,
+		interval:  interval,
+	}
+}
+
+// Run sends deliveries until the context is cancelled
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		sent, err := w.DeliverBatch(ctx)
+		if err != nil {
+			log.Printf("failed to deliver webhooks: %v", err)
+		}
+
+		// Keep going straight away while there's a backlog
+		if err == nil && sent >= w.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(w.interval):
+		}
+	}
+}
+
+// DeliverBatch sends the deliveries that are due at the same time, so one slow endpoint doesn't hold up the others,
+// and returns how many were attempted
+func (w *Worker) DeliverBatch(ctx context.Context) (int, error) {
+	deliveries, err := w.repo.ListDueDeliveries(ctx, w.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	errs := make([]error, len(deliveries))
+	var wg sync.WaitGroup
+	for i, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = w.deliver(ctx, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries), errors.Join(errs...)
+}
+
+// deliver sends the delivery once and records the outcome
+func (w *Worker) deliver(ctx context.Context, delivery entity.WebhookDelivery) error {
+	err := w.deliverer.DeliverOnce(ctx, delivery.Endpoint, delivery.Payload)
+	if err == nil {
+		return w.repo.DeleteDelivery(ctx, delivery.ID)
+	}
+	// Shutting down, so leave the delivery due for the next worker rather than count the attempt
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	attempts := delivery.Attempts + 1
+	if delay, ok := w.deliverer.retryDelay(attempts, err); ok {
+		if err := w.repo.RetryDelivery(ctx, delivery.ID, delay, err.Error()); err != nil {
+			return fmt.Errorf("failed to schedule retry of event %d for webhook %d: %w", delivery.EventID, delivery.Endpoint.ID, err)
+		}
+		return nil
+	}
+
+	log.Printf("dead-lettering event %d for webhook %d after %d attempts: %v", delivery.EventID, delivery.Endpoint.ID, attempts, err)
+	deadLetter := entity.DeadLetter{
+		EndpointID: delivery.Endpoint.ID,
+		EventID:    delivery.EventID,
+		Payload:    delivery.Payload,
+		Attempts:   attempts,
+		LastError:  err.Error(),
+	}
+	return w.repo.DeadLetterDelivery(ctx, delivery.ID, deadLetter)
+}
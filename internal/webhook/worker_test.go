@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shewitt93/explore_service/internal/entity"
+	"github.com/shewitt93/explore_service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverBatch(t *testing.T) {
+	ctx := context.Background()
+	payload := []byte(`{"id":7}`)
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+	gone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer gone.Close()
+
+	t.Run("RecordsEachOutcome", func(t *testing.T) {
+		repo := new(repository.MockWebhookRepository)
+		worker := NewWorker(repo, NewDeliverer(http.DefaultClient, 3, time.Second, time.Minute), 10, time.Second)
+
+		repo.On("ListDueDeliveries", mock.Anything, 10).Return([]entity.WebhookDelivery{
+			{ID: 1, Endpoint: entity.WebhookEndpoint{ID: 1, URL: healthy.URL}, EventID: 7, Payload: payload},
+			{ID: 2, Endpoint: entity.WebhookEndpoint{ID: 2, URL: unavailable.URL}, EventID: 7, Payload: payload, Attempts: 1},
+			{ID: 3, Endpoint: entity.WebhookEndpoint{ID: 3, URL: unavailable.URL}, EventID: 7, Payload: payload, Attempts: 2},
+			{ID: 4, Endpoint: entity.WebhookEndpoint{ID: 4, URL: gone.URL}, EventID: 7, Payload: payload},
+		}, nil)
+		// Delivered
+		repo.On("DeleteDelivery", mock.Anything, int64(1)).Return(nil)
+		// Failed its second attempt, so waits twice the base delay
+		repo.On("RetryDelivery", mock.Anything, int64(2), 2*time.Second, "webhook 2 responded 503 Service Unavailable").Return(nil)
+		// Failed its last attempt
+		repo.On("DeadLetterDelivery", mock.Anything, int64(3), entity.DeadLetter{
+			EndpointID: 3, EventID: 7, Payload: payload, Attempts: 3, LastError: "webhook 3 responded 503 Service Unavailable",
+		}).Return(nil)
+		// Failed permanently on its first attempt
+		repo.On("DeadLetterDelivery", mock.Anything, int64(4), entity.DeadLetter{
+			EndpointID: 4, EventID: 7, Payload: payload, Attempts: 1, LastError: "webhook 4 responded 410 Gone",
+		}).Return(nil)
+
+		sent, err := worker.DeliverBatch(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, sent)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ReportsFailedUpdates", func(t *testing.T) {
+		repo := new(repository.MockWebhookRepository)
+		worker := NewWorker(repo, NewDeliverer(http.DefaultClient, 3, time.Second, time.Minute), 10, time.Second)
+
+		repo.On("ListDueDeliveries", mock.Anything, 10).Return([]entity.WebhookDelivery{
+			{ID: 1, Endpoint: entity.WebhookEndpoint{ID: 1, URL: healthy.URL}, EventID: 7, Payload: payload},
+		}, nil)
+		repo.On("DeleteDelivery", mock.Anything, int64(1)).Return(errors.New("database error"))
+
+		// The delivery stays due, so it's sent again
+		sent, err := worker.DeliverBatch(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, 1, sent)
+	})
+}
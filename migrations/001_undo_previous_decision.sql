@@ -0,0 +1,6 @@
+-- Adds the decision each decision replaced to user_decisions, so the most recent decision can be undone.
+-- Decisions made before this migration can't be undone back to what they replaced.
+
+ALTER TABLE user_decisions
+    ADD COLUMN previous_liked BOOLEAN NULL AFTER updated_at,
+    ADD COLUMN previous_updated_at TIMESTAMP NULL AFTER previous_liked;
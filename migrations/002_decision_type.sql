@@ -0,0 +1,22 @@
+-- Replaces the liked flag of user_decisions with a decision type so super-likes can be recorded.
+-- liked becomes a column derived from the decision type, so queries filtering on it keep working.
+
+ALTER TABLE user_decisions
+    ADD COLUMN decision_type ENUM('pass', 'like', 'super_like') NOT NULL DEFAULT 'pass' AFTER recipient_id,
+    ADD COLUMN previous_decision_type ENUM('pass', 'like', 'super_like') NULL AFTER updated_at;
+
+UPDATE user_decisions
+SET decision_type = IF(liked, 'like', 'pass'),
+    previous_decision_type = CASE previous_liked WHEN TRUE THEN 'like' WHEN FALSE THEN 'pass' END;
+
+ALTER TABLE user_decisions
+    ALTER COLUMN decision_type DROP DEFAULT,
+    DROP INDEX idx_recipient_liked,
+    DROP COLUMN liked,
+    DROP COLUMN previous_liked;
+
+ALTER TABLE user_decisions
+    ADD COLUMN liked BOOLEAN AS (decision_type <> 'pass') STORED AFTER decision_type,
+    ADD COLUMN super_liked BOOLEAN AS (decision_type = 'super_like') STORED AFTER liked,
+    ADD INDEX idx_recipient_liked (recipient_id, liked),
+    ADD INDEX idx_recipient_ranked (recipient_id, liked, super_liked, updated_at, actor_id);
@@ -0,0 +1,15 @@
+-- Adds an append-only history of every change to a decision, written in the same transaction as the change.
+-- Decisions made before this migration have no history.
+
+CREATE TABLE user_decision_history (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    actor_id VARCHAR(255) NOT NULL,
+    recipient_id VARCHAR(255) NOT NULL,
+    -- The decision in place after the change, NULL if the decision was removed
+    decision_type ENUM('pass', 'like', 'super_like') NULL,
+    `change` ENUM('put', 'undo', 'block', 'unmatch') NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    INDEX idx_actor_recipient_created (actor_id, recipient_id, created_at, id)
+
+);
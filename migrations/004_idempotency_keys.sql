@@ -0,0 +1,14 @@
+-- Adds the responses to PutDecision requests made with an idempotency key, so retries get the original response.
+-- Rows past expires_at are ignored and can be deleted at any time.
+
+CREATE TABLE idempotency_keys (
+    user_id VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    -- Hash of the request the key was first used for
+    request_hash BINARY(32) NOT NULL,
+    response BLOB NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, idempotency_key),
+    INDEX idx_expires (expires_at)
+
+);
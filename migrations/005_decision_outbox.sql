@@ -0,0 +1,17 @@
+-- Adds the outbox decision and match events are written to in the same transaction as the decision,
+-- for the relay to publish to downstream services.
+
+CREATE TABLE decision_outbox (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    event_type ENUM('like', 'pass', 'match') NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    recipient_id VARCHAR(255) NOT NULL,
+    -- The decision the actor made, NULL for match events
+    decision_type ENUM('pass', 'like', 'super_like') NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    -- Set once the relay has published the event
+    published_at TIMESTAMP(6) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_unpublished (published_at, id)
+
+);
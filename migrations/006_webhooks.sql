@@ -0,0 +1,29 @@
+-- Adds the partner endpoints like and match events are delivered to,
+-- and the dead letters kept for deliveries that failed every retry.
+
+CREATE TABLE webhook_endpoints (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    url VARCHAR(2048) NOT NULL,
+    -- Signs the payloads delivered to the endpoint
+    secret VARBINARY(64) NOT NULL,
+    event_types SET('like', 'match') NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+
+);
+
+CREATE TABLE webhook_dead_letters (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    endpoint_id BIGINT NOT NULL,
+    -- The decision_outbox event that wasn't delivered
+    event_id BIGINT NOT NULL,
+    payload BLOB NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- Set once a replay delivers the event
+    replayed_at TIMESTAMP NULL,
+    PRIMARY KEY (id),
+    INDEX idx_pending (replayed_at, endpoint_id, id)
+
+);
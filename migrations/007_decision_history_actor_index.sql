@@ -0,0 +1,3 @@
+-- Adds the index like quotas use to find an actor's recent likes across every recipient.
+
+CREATE INDEX idx_actor_created ON user_decision_history (actor_id, created_at);
@@ -0,0 +1,3 @@
+-- Adds the index an actor's decisions are listed from, newest first, so listing them doesn't sort every decision.
+
+CREATE INDEX idx_actor_updated ON user_decisions (actor_id, updated_at, recipient_id);
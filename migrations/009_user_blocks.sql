@@ -0,0 +1,10 @@
+-- Adds the blocks between users. Every liker and decision query checks it, so it must exist before the service is deployed.
+
+CREATE TABLE user_blocks (
+    blocker_id VARCHAR(255) NOT NULL,
+    blocked_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    INDEX idx_blocker_created (blocker_id, created_at, blocked_id)
+
+);
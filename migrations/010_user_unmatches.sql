@@ -0,0 +1,9 @@
+-- Adds the unmatches between users. Every liker query checks it, so it must exist before the service is deployed.
+
+CREATE TABLE user_unmatches (
+    unmatcher_id VARCHAR(255) NOT NULL,
+    unmatched_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (unmatcher_id, unmatched_id)
+
+);
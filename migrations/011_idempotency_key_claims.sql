@@ -0,0 +1,5 @@
+-- Lets a request claim its idempotency key before making the decision, with no response until it finishes,
+-- so a retry arriving while the first request is still running doesn't make the decision again.
+
+ALTER TABLE idempotency_keys
+    MODIFY response BLOB NULL;
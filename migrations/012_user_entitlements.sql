@@ -0,0 +1,9 @@
+-- Adds the like quota tier each user is on. Users without a row are on the default tier.
+
+CREATE TABLE user_entitlements (
+    user_id VARCHAR(255) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id)
+
+);
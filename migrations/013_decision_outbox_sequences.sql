@@ -0,0 +1,13 @@
+-- Numbers each recipient's outbox events in the order they commit. Writing an event locks the recipient's row until
+-- the transaction commits, so their next event can't be written, or published, before it.
+-- Events written before this migration keep sequence 0.
+
+CREATE TABLE decision_outbox_sequences (
+    recipient_id VARCHAR(255) NOT NULL,
+    last_sequence BIGINT NOT NULL,
+    PRIMARY KEY (recipient_id)
+
+);
+
+ALTER TABLE decision_outbox
+    ADD COLUMN sequence BIGINT NOT NULL DEFAULT 0 AFTER recipient_id;
@@ -0,0 +1,11 @@
+-- Stores when a like formed a match, so a repeated like and ListMatches report when the match formed rather than when
+-- either user last decided. Only the like that forms a match has it set, and the later of the two users' is the time
+-- of their current match. Existing matches are backfilled with the later of the two likes.
+
+ALTER TABLE user_decisions
+    ADD COLUMN matched_at TIMESTAMP NULL AFTER previous_updated_at;
+
+UPDATE user_decisions d1
+    JOIN user_decisions d2 ON d2.actor_id = d1.recipient_id AND d2.recipient_id = d1.actor_id
+SET d1.matched_at = GREATEST(d1.updated_at, d2.updated_at), d1.updated_at = d1.updated_at
+WHERE d1.liked = TRUE AND d2.liked = TRUE;
@@ -0,0 +1,19 @@
+-- Queues webhook deliveries, one per endpoint and event, so the relay only writes them and a separate worker posts,
+-- retries and dead-letters them. A delivery is deleted once it succeeds or is dead-lettered.
+
+CREATE TABLE webhook_deliveries (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    endpoint_id BIGINT NOT NULL,
+    -- The decision_outbox event being delivered
+    event_id BIGINT NOT NULL,
+    payload BLOB NOT NULL,
+    -- How many attempts have failed so far
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    next_attempt_at TIMESTAMP(6) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY uniq_endpoint_event (endpoint_id, event_id),
+    INDEX idx_next_attempt (next_attempt_at, id)
+
+);
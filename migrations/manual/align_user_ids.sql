@@ -0,0 +1,43 @@
+-- Aligns the user ID columns with the INT ids of the `user` table and links decisions to it.
+-- This isn't applied with the numbered migrations, as it only works once every ID is an existing user's id.
+--
+-- Before running it:
+--   1. Run the service with USER_ID_FORMAT=numeric and USER_EXISTENCE_CHECK=true so no new bad IDs are written.
+--   2. Check nothing refers to a user that doesn't exist, each of these should return no rows:
+--        SELECT actor_id, recipient_id FROM user_decisions d
+--        WHERE NOT EXISTS (SELECT 1 FROM `user` u WHERE u.id = d.actor_id)
+--           OR NOT EXISTS (SELECT 1 FROM `user` u WHERE u.id = d.recipient_id)
+--           OR d.actor_id NOT REGEXP '^[1-9][0-9]*$' OR d.recipient_id NOT REGEXP '^[1-9][0-9]*$';
+--        SELECT blocker_id, blocked_id FROM user_blocks
+--        WHERE blocker_id NOT REGEXP '^[1-9][0-9]*$' OR blocked_id NOT REGEXP '^[1-9][0-9]*$';
+--        SELECT unmatcher_id, unmatched_id FROM user_unmatches
+--        WHERE unmatcher_id NOT REGEXP '^[1-9][0-9]*$' OR unmatched_id NOT REGEXP '^[1-9][0-9]*$';
+--   3. Delete or fix any rows they return.
+--
+-- The history, outbox and idempotency tables keep their rows after a user is deleted, so only their types change.
+-- Each ALTER rebuilds its table, so run it when the tables can be locked.
+
+ALTER TABLE user_decisions
+    MODIFY actor_id INT NOT NULL,
+    MODIFY recipient_id INT NOT NULL,
+    ADD CONSTRAINT fk_decisions_actor FOREIGN KEY (actor_id) REFERENCES `user` (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_decisions_recipient FOREIGN KEY (recipient_id) REFERENCES `user` (id) ON DELETE CASCADE;
+
+ALTER TABLE user_blocks
+    MODIFY blocker_id INT NOT NULL,
+    MODIFY blocked_id INT NOT NULL;
+
+ALTER TABLE user_unmatches
+    MODIFY unmatcher_id INT NOT NULL,
+    MODIFY unmatched_id INT NOT NULL;
+
+ALTER TABLE user_decision_history
+    MODIFY actor_id INT NOT NULL,
+    MODIFY recipient_id INT NOT NULL;
+
+ALTER TABLE idempotency_keys
+    MODIFY user_id INT NOT NULL;
+
+ALTER TABLE decision_outbox
+    MODIFY actor_id INT NOT NULL,
+    MODIFY recipient_id INT NOT NULL;
@@ -17,49 +17,49 @@ INSERT INTO user (id, email, name) VALUES
 
 -- Insert sample decisions
 -- User 1 likes several users
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('1', '2', TRUE, '2025-01-15 10:30:00', '2025-01-15 10:30:00'),
-('1', '3', TRUE, '2025-01-16 11:45:00', '2025-01-16 11:45:00'),
-('1', '4', FALSE, '2025-01-17 09:15:00', '2025-01-17 09:15:00'),
-('1', '5', TRUE, '2025-01-18 14:20:00', '2025-01-18 14:20:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('1', '2', 'like', '2025-01-15 10:30:00', '2025-01-15 10:30:00'),
+('1', '3', 'like', '2025-01-16 11:45:00', '2025-01-16 11:45:00'),
+('1', '4', 'pass', '2025-01-17 09:15:00', '2025-01-17 09:15:00'),
+('1', '5', 'like', '2025-01-18 14:20:00', '2025-01-18 14:20:00');
 
 -- User 2 likes several users including User 1 (mutual like)
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('2', '1', TRUE, '2025-01-20 16:30:00', '2025-01-20 16:30:00'),
-('2', '3', FALSE, '2025-01-21 09:45:00', '2025-01-21 09:45:00'),
-('2', '4', TRUE, '2025-01-22 11:10:00', '2025-01-22 11:10:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('2', '1', 'like', '2025-01-20 16:30:00', '2025-01-20 16:30:00'),
+('2', '3', 'pass', '2025-01-21 09:45:00', '2025-01-21 09:45:00'),
+('2', '4', 'like', '2025-01-22 11:10:00', '2025-01-22 11:10:00');
 
 -- User 3 likes User 1 (mutual like)
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('3', '1', TRUE, '2025-01-23 08:30:00', '2025-01-23 08:30:00'),
-('3', '2', TRUE, '2025-01-24 10:15:00', '2025-01-24 10:15:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('3', '1', 'like', '2025-01-23 08:30:00', '2025-01-23 08:30:00'),
+('3', '2', 'like', '2025-01-24 10:15:00', '2025-01-24 10:15:00');
 
 -- User 4 doesn't like User 1
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('4', '1', FALSE, '2025-01-25 14:40:00', '2025-01-25 14:40:00'),
-('4', '2', TRUE, '2025-01-26 16:20:00', '2025-01-26 16:20:00'),
-('4', '3', TRUE, '2025-01-27 11:30:00', '2025-01-27 11:30:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('4', '1', 'pass', '2025-01-25 14:40:00', '2025-01-25 14:40:00'),
+('4', '2', 'like', '2025-01-26 16:20:00', '2025-01-26 16:20:00'),
+('4', '3', 'like', '2025-01-27 11:30:00', '2025-01-27 11:30:00');
 
 -- User 5 likes User 1 but User 1 already liked User 5 (mutual like)
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('5', '1', TRUE, '2025-01-28 09:50:00', '2025-01-28 09:50:00'),
-('5', '2', FALSE, '2025-01-29 13:25:00', '2025-01-29 13:25:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('5', '1', 'like', '2025-01-28 09:50:00', '2025-01-28 09:50:00'),
+('5', '2', 'pass', '2025-01-29 13:25:00', '2025-01-29 13:25:00');
 
 -- More users like User 1
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('6', '1', TRUE, '2025-02-01 10:10:00', '2025-02-01 10:10:00'),
-('7', '1', TRUE, '2025-02-02 15:45:00', '2025-02-02 15:45:00'),
-('8', '1', FALSE, '2025-02-03 12:30:00', '2025-02-03 12:30:00'),
-('9', '1', TRUE, '2025-02-04 16:20:00', '2025-02-04 16:20:00'),
-('10', '1', TRUE, '2025-02-05 11:15:00', '2025-02-05 11:15:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('6', '1', 'like', '2025-02-01 10:10:00', '2025-02-01 10:10:00'),
+('7', '1', 'like', '2025-02-02 15:45:00', '2025-02-02 15:45:00'),
+('8', '1', 'pass', '2025-02-03 12:30:00', '2025-02-03 12:30:00'),
+('9', '1', 'like', '2025-02-04 16:20:00', '2025-02-04 16:20:00'),
+('10', '1', 'like', '2025-02-05 11:15:00', '2025-02-05 11:15:00');
 
 -- Add a few additional connections
-INSERT INTO user_decisions (actor_id, recipient_id, liked, created_at, updated_at) VALUES
-('6', '7', TRUE, '2025-02-06 14:30:00', '2025-02-06 14:30:00'),
-('7', '6', TRUE, '2025-02-07 09:20:00', '2025-02-07 09:20:00'),
-('8', '9', TRUE, '2025-02-08 10:45:00', '2025-02-08 10:45:00'),
-('9', '8', FALSE, '2025-02-09 13:10:00', '2025-02-09 13:10:00'),
-('10', '5', TRUE, '2025-02-10 15:30:00', '2025-02-10 15:30:00');
+INSERT INTO user_decisions (actor_id, recipient_id, decision_type, created_at, updated_at) VALUES
+('6', '7', 'like', '2025-02-06 14:30:00', '2025-02-06 14:30:00'),
+('7', '6', 'like', '2025-02-07 09:20:00', '2025-02-07 09:20:00'),
+('8', '9', 'like', '2025-02-08 10:45:00', '2025-02-08 10:45:00'),
+('9', '8', 'pass', '2025-02-09 13:10:00', '2025-02-09 13:10:00'),
+('10', '5', 'like', '2025-02-10 15:30:00', '2025-02-10 15:30:00');
 
 -- Select statements to verify the data
 SELECT 'Users:' as '';